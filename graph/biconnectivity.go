@@ -0,0 +1,180 @@
+package graph
+
+import (
+	"slices"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// ArticulationPoints returns the set of articulation points of graph g, which
+// are the nodes whose removal, along with their edges, would split a
+// connected component of g into more than one; in other words, they are the
+// single points of failure of g. The direction of g's edges, if any, does
+// not matter.
+//
+// The returned set is a read-only snapshot; it does not reflect later changes
+// to g.
+//
+// ArticulationPoints runs an iterative depth-first search, so it runs in
+// O(V + E) time and does not grow the call stack on large graphs.
+func ArticulationPoints[N comparable](g GraphView[N]) SetView[N] {
+	return set.Unmodifiable[N](biconnectivityOf(g).articulationPoints)
+}
+
+// Bridges returns the set of bridges of graph g, which are the edges whose
+// removal would split a connected component of g into more than one. The
+// direction of g's edges, if any, does not matter, but each returned pair is
+// an edge of g. Self-loops are never bridges.
+//
+// The returned set is a read-only snapshot; it does not reflect later changes
+// to g.
+//
+// Bridges runs an iterative depth-first search, so it runs in O(V + E) time
+// and does not grow the call stack on large graphs.
+func Bridges[N comparable](g GraphView[N]) SetView[EndpointPair[N]] {
+	return set.Unmodifiable[EndpointPair[N]](biconnectivityOf(g).bridges)
+}
+
+// BiconnectedComponents returns the biconnected components of graph g, as
+// sets of nodes. A biconnected component is a largest possible set of nodes
+// that stays connected after the removal of any one of them. Every edge of g
+// that is not a self-loop connects the nodes of exactly one component, so a
+// bridge is a component of its own, and nodes with no such edges are in no
+// component. Components can share nodes, which are the articulation points of
+// g (see ArticulationPoints). The direction of g's edges, if any, does not
+// matter. The components are returned in an undefined order.
+//
+// The returned sets are read-only snapshots; they do not reflect later changes
+// to g.
+//
+// BiconnectedComponents runs an iterative depth-first search, so it runs in
+// O(V + E) time and does not grow the call stack on large graphs.
+func BiconnectedComponents[N comparable](g GraphView[N]) []SetView[N] {
+	return biconnectivityOf(g).components
+}
+
+type biconnectivity[N comparable] struct {
+	articulationPoints set.Set[N]
+	bridges            set.Set[EndpointPair[N]]
+	components         []SetView[N]
+}
+
+// biconnectivityFrame is the state of a node on the stack of the depth-first
+// search in biconnectivityOf, which would be the local variables of a
+// recursive implementation.
+type biconnectivityFrame[N comparable] struct {
+	node      N
+	parent    N
+	hasParent bool
+	neighbors []N
+	// next is the index of the next neighbor to visit.
+	next int
+}
+
+// biconnectivityOf finds the articulation points, bridges and biconnected
+// components of g with Tarjan's algorithm, which assigns each node its
+// discovery time in a depth-first search and the lowest discovery time that
+// can be reached from its subtree with at most one back edge.
+func biconnectivityOf[N comparable](g GraphView[N]) biconnectivity[N] {
+	result := biconnectivity[N]{
+		articulationPoints: set.Of[N](),
+		bridges:            set.Of[EndpointPair[N]](),
+	}
+	nodeToDiscovery := make(map[N]int, g.Nodes().Len())
+	nodeToLow := make(map[N]int, g.Nodes().Len())
+	// edges has the edges that have been visited but that are not yet in a
+	// component.
+	var edges []EndpointPair[N]
+	var stack []biconnectivityFrame[N]
+	visit := func(node N, parent N, hasParent bool) {
+		nodeToDiscovery[node] = len(nodeToDiscovery)
+		nodeToLow[node] = nodeToDiscovery[node]
+		stack = append(stack, biconnectivityFrame[N]{
+			node:      node,
+			parent:    parent,
+			hasParent: hasParent,
+			neighbors: slices.Collect(g.AdjacentNodes(node).All()),
+		})
+	}
+
+	for root := range g.Nodes().All() {
+		if _, ok := nodeToDiscovery[root]; ok {
+			continue
+		}
+
+		rootChildren := 0
+		visit(root, root, false)
+		for len(stack) > 0 {
+			frame := &stack[len(stack)-1]
+			node := frame.node
+			if frame.next < len(frame.neighbors) {
+				next := frame.neighbors[frame.next]
+				frame.next++
+				if next == node || frame.hasParent && next == frame.parent {
+					continue
+				}
+
+				nextDiscovery, ok := nodeToDiscovery[next]
+				if !ok {
+					edges = append(edges, EndpointPairOf(node, next))
+					if node == root {
+						rootChildren++
+					}
+					visit(next, node, true)
+				} else if nextDiscovery < nodeToDiscovery[node] {
+					// A back edge to an ancestor. An edge to a descendant
+					// was already seen from the descendant's side.
+					edges = append(edges, EndpointPairOf(node, next))
+					nodeToLow[node] = min(nodeToLow[node], nextDiscovery)
+				}
+				continue
+			}
+
+			// All of node's neighbors have been visited, so return to its
+			// parent.
+			parent, hasParent := frame.parent, frame.hasParent
+			stack = stack[:len(stack)-1]
+			if !hasParent {
+				continue
+			}
+			nodeToLow[parent] = min(nodeToLow[parent], nodeToLow[node])
+			if nodeToLow[node] > nodeToDiscovery[parent] {
+				result.bridges.Add(edgeBetween(g, parent, node))
+			}
+			if nodeToLow[node] >= nodeToDiscovery[parent] {
+				// No node in node's subtree can reach above parent without
+				// going through it, so the edges visited since the edge from
+				// parent to node make a component.
+				if parent != root {
+					result.articulationPoints.Add(parent)
+				}
+				component := set.Of[N]()
+				for {
+					edge := edges[len(edges)-1]
+					edges = edges[:len(edges)-1]
+					component.Add(edge.Source(), edge.Target())
+					if edge.Source() == parent && edge.Target() == node {
+						break
+					}
+				}
+				result.components = append(
+					result.components,
+					set.Unmodifiable[N](component),
+				)
+			}
+		}
+		if rootChildren > 1 {
+			result.articulationPoints.Add(root)
+		}
+	}
+	return result
+}
+
+// edgeBetween returns the edge of g between adjacent nodes a and b, in the
+// direction that g has it if g is directed.
+func edgeBetween[N comparable](g GraphView[N], a N, b N) EndpointPair[N] {
+	if g.HasEdgeConnecting(a, b) {
+		return EndpointPairOf(a, b)
+	}
+	return EndpointPairOf(b, a)
+}
@@ -0,0 +1,337 @@
+package graph_test
+
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+func TestBiconnectivity(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name                   string
+		g                      *graph.Graph[int]
+		wantArticulationPoints []int
+		wantBridges            []graph.EndpointPair[int]
+		wantComponents         [][]int
+	}
+	twoTriangles := undirectedIntGraphOf(
+		edgeOf(1, 2),
+		edgeOf(2, 3),
+		edgeOf(3, 1),
+		edgeOf(3, 4),
+		edgeOf(4, 5),
+		edgeOf(5, 6),
+		edgeOf(6, 4),
+		edgeOf(6, 7),
+	)
+	twoTriangles.AddNode(8)
+	selfLoop := graph.Undirected[int]().AllowsSelfLoops(true).Build()
+	selfLoop.PutEdge(1, 1)
+	selfLoop.PutEdge(1, 2)
+	tests := []testCase{
+		{
+			name: "empty graph",
+			g:    graph.Undirected[int]().Build(),
+		},
+		{
+			name:        "undirected: one edge",
+			g:           undirectedIntGraphOf(edgeOf(1, 2)),
+			wantBridges: []graph.EndpointPair[int]{edgeOf(1, 2)},
+			wantComponents: [][]int{
+				{1, 2},
+			},
+		},
+		{
+			name:                   "undirected: two triangles joined by a bridge",
+			g:                      twoTriangles,
+			wantArticulationPoints: []int{3, 4, 6},
+			wantBridges: []graph.EndpointPair[int]{
+				edgeOf(3, 4),
+				edgeOf(6, 7),
+			},
+			wantComponents: [][]int{
+				{1, 2, 3},
+				{3, 4},
+				{4, 5, 6},
+				{6, 7},
+			},
+		},
+		{
+			name: "undirected: star",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(1, 3),
+				edgeOf(1, 4),
+			),
+			wantArticulationPoints: []int{1},
+			wantBridges: []graph.EndpointPair[int]{
+				edgeOf(1, 2),
+				edgeOf(1, 3),
+				edgeOf(1, 4),
+			},
+			wantComponents: [][]int{
+				{1, 2},
+				{1, 3},
+				{1, 4},
+			},
+		},
+		{
+			name: "undirected: two cycles sharing a node",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 1),
+				edgeOf(1, 4),
+				edgeOf(4, 5),
+				edgeOf(5, 6),
+				edgeOf(6, 1),
+			),
+			wantArticulationPoints: []int{1},
+			wantComponents: [][]int{
+				{1, 2, 3},
+				{1, 4, 5, 6},
+			},
+		},
+		{
+			name:        "undirected: self-loop",
+			g:           selfLoop,
+			wantBridges: []graph.EndpointPair[int]{edgeOf(1, 2)},
+			wantComponents: [][]int{
+				{1, 2},
+			},
+		},
+		{
+			name:                   "directed: path",
+			g:                      directedIntGraphOf(edgeOf(1, 2), edgeOf(3, 2)),
+			wantArticulationPoints: []int{2},
+			wantBridges: []graph.EndpointPair[int]{
+				edgeOf(1, 2),
+				edgeOf(3, 2),
+			},
+			wantComponents: [][]int{
+				{1, 2},
+				{2, 3},
+			},
+		},
+		{
+			name: "directed: cycle",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 1),
+			),
+			wantComponents: [][]int{
+				{1, 2, 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			articulationPoints := graph.ArticulationPoints(tt.g)
+			bridges := graph.Bridges(tt.g)
+			components := graph.BiconnectedComponents(tt.g)
+
+			if want := set.Of(tt.wantArticulationPoints...); !set.Equal[int](articulationPoints, want) {
+				t.Errorf("graph.ArticulationPoints: got %v, want %v", articulationPoints, want)
+			}
+			if !tt.g.IsDirected() {
+				// Either orientation of an undirected edge is fine.
+				bridges = set.Of(slices.Collect(sortedEndpoints(bridges.All()))...)
+			}
+			if want := set.Of(tt.wantBridges...); !set.Equal[graph.EndpointPair[int]](bridges, want) {
+				t.Errorf("graph.Bridges: got %v, want %v", bridges, want)
+			}
+			if got := sortedComponents(components); !slices.EqualFunc(got, tt.wantComponents, slices.Equal) {
+				t.Errorf("graph.BiconnectedComponents: got %v, want %v", got, tt.wantComponents)
+			}
+		})
+	}
+}
+
+func TestBiconnectivityOnRandomGraphs(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(7, 8))
+	for range 50 {
+		g := graph.Undirected[int]().Build()
+		for node := range 12 {
+			g.AddNode(node)
+		}
+		for range 14 {
+			source, target := r.IntN(12), r.IntN(12)
+			if source != target {
+				g.PutEdge(source, target)
+			}
+		}
+
+		articulationPoints := graph.ArticulationPoints(g)
+		bridges := graph.Bridges(g)
+		components := graph.BiconnectedComponents(g)
+
+		componentCount := connectedComponents(g)
+		for node := range g.Nodes().All() {
+			without := graph.Filter(g, func(n int) bool { return n != node }, nil)
+			// Removing a node with no edges takes its component away, and
+			// removing any other node only adds components if the node is an
+			// articulation point.
+			isArticulationPoint := connectedComponents(without) > componentCount
+			if got := articulationPoints.Contains(node); got != isArticulationPoint {
+				t.Errorf(
+					"graph.ArticulationPoints: got %t for node %d of %v, want %t",
+					got,
+					node,
+					g,
+					isArticulationPoint,
+				)
+			}
+		}
+		for edge := range g.Edges().All() {
+			without := graph.Filter(g, nil, func(e graph.EndpointPair[int]) bool {
+				return !sameUndirectedEdge(e, edge)
+			})
+			isBridge := connectedComponents(without) > componentCount
+			if got := bridges.Contains(edge) || bridges.Contains(reversed(edge)); got != isBridge {
+				t.Errorf(
+					"graph.Bridges: got %t for edge %v of %v, want %t",
+					got,
+					edge,
+					g,
+					isBridge,
+				)
+			}
+		}
+		assertBiconnectedComponents(t, g, components, articulationPoints)
+	}
+}
+
+func TestBiconnectivityOnLongPath(t *testing.T) {
+	t.Parallel()
+
+	// A recursive depth-first search would go this many calls deep.
+	const nodes = 50_000
+	g := graph.Undirected[int]().Build()
+	for node := 1; node < nodes; node++ {
+		g.PutEdge(node-1, node)
+	}
+
+	if got, want := graph.ArticulationPoints(g).Len(), nodes-2; got != want {
+		t.Errorf("graph.ArticulationPoints: got %d nodes, want %d", got, want)
+	}
+	if got, want := graph.Bridges(g).Len(), nodes-1; got != want {
+		t.Errorf("graph.Bridges: got %d edges, want %d", got, want)
+	}
+	if got, want := len(graph.BiconnectedComponents(g)), nodes-1; got != want {
+		t.Errorf("graph.BiconnectedComponents: got %d components, want %d", got, want)
+	}
+}
+
+// assertBiconnectedComponents checks that every edge of g connects the nodes
+// of exactly one of components, that no component has an articulation point
+// of its own, and that components only share articulation points of g.
+func assertBiconnectedComponents(
+	t *testing.T,
+	g *graph.Graph[int],
+	components []graph.SetView[int],
+	articulationPoints graph.SetView[int],
+) {
+	t.Helper()
+
+	for edge := range g.Edges().All() {
+		count := 0
+		for _, component := range components {
+			if component.Contains(edge.Source()) && component.Contains(edge.Target()) {
+				count++
+			}
+		}
+		if count != 1 {
+			t.Errorf(
+				"graph.BiconnectedComponents: got %v, in which edge %v is in %d components, want 1",
+				components,
+				edge,
+				count,
+			)
+		}
+	}
+	for i, component := range components {
+		subgraph := graph.InducedSubgraph(g, component.All())
+		if subgraph.Nodes().Len() < 2 || connectedComponents(subgraph) != 1 ||
+			graph.ArticulationPoints(subgraph).Len() != 0 {
+			t.Errorf(
+				"graph.BiconnectedComponents: got component %v, which is not biconnected",
+				component,
+			)
+		}
+		for _, other := range components[i+1:] {
+			for node := range component.All() {
+				if other.Contains(node) && !articulationPoints.Contains(node) {
+					t.Errorf(
+						"graph.BiconnectedComponents: got components %v and %v, which share node %d",
+						component,
+						other,
+						node,
+					)
+				}
+			}
+		}
+	}
+}
+
+// connectedComponents returns the number of connected components of
+// undirected graph g.
+func connectedComponents(g graph.GraphView[int]) int {
+	result := 0
+	visited := set.Of[int]()
+	for node := range g.Nodes().All() {
+		if visited.Contains(node) {
+			continue
+		}
+		result++
+		for reachable := range graph.ReachableNodes(g, node).All() {
+			visited.Add(reachable)
+		}
+	}
+	return result
+}
+
+// sortedEndpoints returns each of edges with its lower node as its source.
+func sortedEndpoints(
+	edges iter.Seq[graph.EndpointPair[int]],
+) iter.Seq[graph.EndpointPair[int]] {
+	return func(yield func(graph.EndpointPair[int]) bool) {
+		for edge := range edges {
+			if edge.Source() > edge.Target() {
+				edge = reversed(edge)
+			}
+			if !yield(edge) {
+				return
+			}
+		}
+	}
+}
+
+func sameUndirectedEdge(a, b graph.EndpointPair[int]) bool {
+	return a == b || a == reversed(b)
+}
+
+func reversed(e graph.EndpointPair[int]) graph.EndpointPair[int] {
+	return graph.EndpointPairOf(e.Target(), e.Source())
+}
+
+// sortedComponents returns components as sorted slices, in order.
+func sortedComponents(components []graph.SetView[int]) [][]int {
+	result := make([][]int, 0, len(components))
+	for _, component := range components {
+		result = append(result, slices.Sorted(component.All()))
+	}
+	slices.SortFunc(result, slices.Compare)
+	return result
+}
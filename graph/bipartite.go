@@ -0,0 +1,75 @@
+package graph
+
+import "slices"
+
+// IsBipartite returns whether graph g is bipartite, which means that its nodes
+// can be coloured with two colours such that every edge connects two nodes of
+// different colours, like jobs and the workers who can do them. The direction
+// of g's edges, if any, does not matter.
+//
+// If g is bipartite, IsBipartite returns such a colouring, as a new map from
+// every node of g to either 0 or 1, along with a nil odd cycle and true. If
+// there is more than one such colouring, which one is returned is undefined.
+//
+// Otherwise, g has a cycle with an odd number of edges, which no two-colouring
+// can work for, so IsBipartite returns a nil colouring, one such cycle as
+// proof, and false. The cycle is a slice of nodes in which each node is
+// adjacent to the next one and the last node is adjacent to the first one. A
+// self-loop is a cycle of one node.
+//
+// IsBipartite runs a breadth-first search from each connected component of g,
+// so it runs in O(V + E) time.
+func IsBipartite[N comparable](
+	g GraphView[N],
+) (colouring map[N]int, oddCycle []N, ok bool) {
+	nodeToColour := make(map[N]int, g.Nodes().Len())
+	nodeToParent := make(map[N]N, g.Nodes().Len())
+	for root := range g.Nodes().All() {
+		if _, ok := nodeToColour[root]; ok {
+			continue
+		}
+
+		nodeToColour[root] = 0
+		nodeToParent[root] = root
+		queue := []N{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			for next := range g.AdjacentNodes(node).All() {
+				nextColour, ok := nodeToColour[next]
+				if !ok {
+					nodeToColour[next] = 1 - nodeToColour[node]
+					nodeToParent[next] = node
+					queue = append(queue, next)
+					continue
+				}
+				if nextColour == nodeToColour[node] {
+					return nil, oddCycleThrough(nodeToParent, node, next), false
+				}
+			}
+		}
+	}
+	return nodeToColour, nil, true
+}
+
+// oddCycleThrough returns the cycle made of the edge from a to b, whose nodes
+// have the same colour, and the paths from a and b back to the node where
+// their branches of the breadth-first search tree in nodeToParent meet.
+func oddCycleThrough[N comparable](nodeToParent map[N]N, a N, b N) []N {
+	// a and b have the same colour, so they are at the same depth in the
+	// tree, and going up from both at once meets at their lowest common
+	// ancestor.
+	fromA, fromB := []N{a}, []N{b}
+	for a != b {
+		a, b = nodeToParent[a], nodeToParent[b]
+		fromA = append(fromA, a)
+		fromB = append(fromB, b)
+	}
+
+	// The cycle goes down from the common ancestor to a, across to b, and up
+	// from b to just below the common ancestor. For a self-loop, a and b are
+	// the same node, which is the whole cycle.
+	slices.Reverse(fromA)
+	return append(fromA, fromB[:len(fromB)-1]...)
+}
@@ -0,0 +1,238 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+func TestIsBipartite(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name          string
+		g             *graph.Graph[int]
+		want          bool
+		wantCycleLen  int
+		wantColouring map[int]int
+	}
+	selfLoop := graph.Undirected[int]().AllowsSelfLoops(true).Build()
+	selfLoop.PutEdge(1, 2)
+	selfLoop.PutEdge(2, 2)
+	tests := []testCase{
+		{
+			name: "empty graph",
+			g:    graph.Undirected[int]().Build(),
+			want: true,
+		},
+		{
+			name: "undirected: even cycle",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 4),
+				edgeOf(4, 1),
+			),
+			want: true,
+		},
+		{
+			name: "undirected: triangle",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 1),
+			),
+			want:         false,
+			wantCycleLen: 3,
+		},
+		{
+			name: "undirected: odd cycle after a path",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 4),
+				edgeOf(4, 5),
+				edgeOf(5, 6),
+				edgeOf(6, 7),
+				edgeOf(7, 3),
+			),
+			want:         false,
+			wantCycleLen: 5,
+		},
+		{
+			name: "undirected: bipartite component and odd cycle component",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(10, 11),
+				edgeOf(11, 12),
+				edgeOf(12, 13),
+				edgeOf(13, 14),
+				edgeOf(14, 10),
+			),
+			want:         false,
+			wantCycleLen: 5,
+		},
+		{
+			name:         "undirected: self-loop",
+			g:            selfLoop,
+			want:         false,
+			wantCycleLen: 1,
+		},
+		{
+			name: "directed: edges into the same node",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(3, 2), edgeOf(3, 4)),
+			want: true,
+		},
+		{
+			name: "directed: triangle against the direction of an edge",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(1, 3),
+			),
+			want:         false,
+			wantCycleLen: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			colouring, oddCycle, ok := graph.IsBipartite(tt.g)
+
+			if ok != tt.want {
+				t.Fatalf(
+					"graph.IsBipartite: got (%v, %v, %t), want ok %t",
+					colouring,
+					oddCycle,
+					ok,
+					tt.want,
+				)
+			}
+			if ok {
+				assertColouring(t, tt.g, colouring, oddCycle)
+				return
+			}
+			assertOddCycle(t, tt.g, colouring, oddCycle)
+			if len(oddCycle) != tt.wantCycleLen {
+				t.Errorf(
+					"graph.IsBipartite: got odd cycle %v, want one with %d nodes",
+					oddCycle,
+					tt.wantCycleLen,
+				)
+			}
+		})
+	}
+}
+
+func TestIsBipartiteOnRandomGraphs(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 100 {
+		g := graph.Undirected[int]().AllowsSelfLoops(true).Build()
+		for range 12 {
+			g.PutEdge(r.IntN(15), 15+r.IntN(15))
+		}
+		bipartite := true
+		if r.IntN(2) == 0 {
+			// An edge within one side, which may be a self-loop, may or may
+			// not make an odd cycle.
+			g.PutEdge(r.IntN(15), r.IntN(15))
+			bipartite = false
+		}
+
+		colouring, oddCycle, ok := graph.IsBipartite(g)
+
+		if ok {
+			assertColouring(t, g, colouring, oddCycle)
+		} else {
+			assertOddCycle(t, g, colouring, oddCycle)
+		}
+		if bipartite && !ok {
+			t.Errorf(
+				"graph.IsBipartite: got (%v, %v, %t) for bipartite graph %v",
+				colouring,
+				oddCycle,
+				ok,
+				g,
+			)
+		}
+	}
+}
+
+// assertColouring checks that colouring colours every node of g with 0 or 1,
+// and every edge of g connects nodes of different colours.
+func assertColouring(
+	t *testing.T,
+	g *graph.Graph[int],
+	colouring map[int]int,
+	oddCycle []int,
+) {
+	t.Helper()
+
+	if oddCycle != nil {
+		t.Errorf("graph.IsBipartite: got odd cycle %v, want nil", oddCycle)
+	}
+	if len(colouring) != g.Nodes().Len() {
+		t.Errorf(
+			"graph.IsBipartite: got colouring %v, want one for the nodes %v",
+			colouring,
+			g.Nodes(),
+		)
+	}
+	for node := range g.Nodes().All() {
+		if colour, ok := colouring[node]; !ok || (colour != 0 && colour != 1) {
+			t.Errorf(
+				"graph.IsBipartite: got colour (%d, %t) for node %d, want 0 or 1",
+				colour,
+				ok,
+				node,
+			)
+		}
+	}
+	for edge := range g.Edges().All() {
+		if colouring[edge.Source()] == colouring[edge.Target()] {
+			t.Errorf(
+				"graph.IsBipartite: got colouring %v, in which edge %v connects nodes of the same colour",
+				colouring,
+				edge,
+			)
+		}
+	}
+}
+
+// assertOddCycle checks that oddCycle is a cycle in g, ignoring the direction
+// of g's edges, with an odd number of distinct nodes.
+func assertOddCycle(
+	t *testing.T,
+	g *graph.Graph[int],
+	colouring map[int]int,
+	oddCycle []int,
+) {
+	t.Helper()
+
+	if colouring != nil {
+		t.Errorf("graph.IsBipartite: got colouring %v, want nil", colouring)
+	}
+	if len(oddCycle)%2 == 0 || set.Of(oddCycle...).Len() != len(oddCycle) {
+		t.Fatalf(
+			"graph.IsBipartite: got %v, want an odd number of distinct nodes",
+			oddCycle,
+		)
+	}
+	for i, node := range oddCycle {
+		next := oddCycle[(i+1)%len(oddCycle)]
+		if !g.HasEdgeConnecting(node, next) && !g.HasEdgeConnecting(next, node) {
+			t.Errorf(
+				"graph.IsBipartite: got odd cycle %v, but nodes %d and %d are not adjacent",
+				oddCycle,
+				node,
+				next,
+			)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// DegreeCentrality returns the degree centrality of each node of graph g,
+// which is the node's degree (see GraphView.Degree) divided by the number of
+// other nodes, so that a node adjacent to every other node has a centrality
+// of 1. If g is directed, a node's degree is the sum of its in-degree and
+// out-degree, so its centrality can be as high as 2. If g has only one node,
+// that node has a centrality of 1.
+//
+// DegreeCentrality runs in O(V) time if GraphView.Degree runs in constant
+// time.
+func DegreeCentrality[N comparable](g GraphView[N]) map[N]float64 {
+	result := make(map[N]float64, g.Nodes().Len())
+	others := float64(g.Nodes().Len() - 1)
+	for node := range g.Nodes().All() {
+		if others == 0 {
+			result[node] = 1
+			continue
+		}
+		result[node] = float64(g.Degree(node)) / others
+	}
+	return result
+}
+
+// ClosenessCentrality returns the closeness centrality of each node of graph
+// g, which is higher for nodes that can reach the other nodes in fewer edges.
+// It is the number of other nodes reachable from the node divided by the sum
+// of their distances from it, scaled by the fraction of other nodes that are
+// reachable, so that nodes which reach few others do not score highly just
+// because those others are close. A node adjacent to every other node has a
+// centrality of 1, and a node that reaches no other node has a centrality of
+// 0.
+//
+// If g is directed, distances follow the direction of g's edges, otherwise
+// they follow g.AdjacentNodes. To measure how close the other nodes are to a
+// node instead, pass Transpose(g).
+//
+// ClosenessCentrality runs a breadth-first search from each node, so it runs
+// in O(V * (V + E)) time.
+func ClosenessCentrality[N comparable](g GraphView[N]) map[N]float64 {
+	result := make(map[N]float64, g.Nodes().Len())
+	others := float64(g.Nodes().Len() - 1)
+	for node := range g.Nodes().All() {
+		reached, sum := 0, 0
+		for _, distance := range Distances(g, node) {
+			if distance > 0 {
+				reached++
+				sum += distance
+			}
+		}
+		if sum == 0 {
+			result[node] = 0
+			continue
+		}
+		result[node] = float64(reached) / float64(sum) *
+			float64(reached) / others
+	}
+	return result
+}
+
+// BetweennessCentrality returns the betweenness centrality of each node of
+// graph g, which is the fraction of shortest paths between pairs of other
+// nodes that go through the node, summed over all such pairs and divided by
+// the number of pairs, so that it is between 0 and 1. If there is more than
+// one shortest path between a pair of nodes, each path counts as an equal
+// fraction of it.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes.
+//
+// BetweennessCentrality uses Brandes' algorithm, which runs a breadth-first
+// search from each node, so it runs in O(V * (V + E)) time.
+func BetweennessCentrality[N comparable](g GraphView[N]) map[N]float64 {
+	result := make(map[N]float64, g.Nodes().Len())
+	for node := range g.Nodes().All() {
+		result[node] = 0
+	}
+
+	for source := range g.Nodes().All() {
+		// Find the number of shortest paths from source to each node, and the
+		// nodes in order of their distance from source.
+		nodeToDistance := map[N]int{source: 0}
+		nodeToPathCount := map[N]float64{source: 1}
+		nodeToPredecessors := make(map[N][]N)
+		order := []N{source}
+		for i := 0; i < len(order); i++ {
+			node := order[i]
+			for next := range outgoingNodes(g, node).All() {
+				distance, ok := nodeToDistance[next]
+				if !ok {
+					distance = nodeToDistance[node] + 1
+					nodeToDistance[next] = distance
+					order = append(order, next)
+				}
+				if distance == nodeToDistance[node]+1 {
+					nodeToPathCount[next] += nodeToPathCount[node]
+					nodeToPredecessors[next] = append(
+						nodeToPredecessors[next],
+						node,
+					)
+				}
+			}
+		}
+
+		// Accumulate, from the furthest nodes back, the fraction of shortest
+		// paths from source that go through each node.
+		nodeToDependency := make(map[N]float64, len(order))
+		for i := len(order) - 1; i > 0; i-- {
+			node := order[i]
+			for _, predecessor := range nodeToPredecessors[node] {
+				nodeToDependency[predecessor] += nodeToPathCount[predecessor] /
+					nodeToPathCount[node] * (1 + nodeToDependency[node])
+			}
+			result[node] += nodeToDependency[node]
+		}
+	}
+
+	// Each pair of nodes is counted in both directions, even if g is
+	// undirected, so divide by the number of ordered pairs.
+	n := float64(g.Nodes().Len())
+	if n > 2 {
+		for node := range result {
+			result[node] /= (n - 1) * (n - 2)
+		}
+	}
+	return result
+}
+
+// PageRank returns the PageRank of each node of graph g, which is the
+// probability of being at the node after many steps of a random walk that, at
+// each step, follows a random outgoing edge with probability damping and
+// jumps to a random node otherwise. A walk at a node with no outgoing edges
+// always jumps to a random node. The returned ranks sum to 1.
+//
+// If g is directed, the walk follows the direction of g's edges, otherwise it
+// follows g.AdjacentNodes.
+//
+// PageRank uses power iteration, stopping once the sum of the changes to the
+// ranks in one iteration is less than tolerance. Each iteration runs in
+// O(V + E) time, and the number of iterations grows with damping; 0.85 is a
+// common choice.
+//
+// PageRank panics if damping is not in the range [0, 1) or if tolerance is
+// not positive.
+func PageRank[N comparable](
+	g GraphView[N],
+	damping float64,
+	tolerance float64,
+) map[N]float64 {
+	if !(damping >= 0 && damping < 1) {
+		panic(fmt.Sprintf("damping %v is not in the range [0, 1)", damping))
+	}
+	if !(tolerance > 0) {
+		panic(fmt.Sprintf("tolerance %v is not positive", tolerance))
+	}
+
+	n := float64(g.Nodes().Len())
+	ranks := make(map[N]float64, g.Nodes().Len())
+	nodeToOutgoing := make(map[N][]N, g.Nodes().Len())
+	for node := range g.Nodes().All() {
+		ranks[node] = 1 / n
+		for next := range outgoingNodes(g, node).All() {
+			nodeToOutgoing[node] = append(nodeToOutgoing[node], next)
+		}
+	}
+
+	next := make(map[N]float64, len(ranks))
+	for len(ranks) > 0 {
+		// The rank of nodes with no outgoing edges is spread over every
+		// node, along with the rank of the walks that jump.
+		dangling := 0.0
+		for node, rank := range ranks {
+			if len(nodeToOutgoing[node]) == 0 {
+				dangling += rank
+			}
+		}
+		base := (1-damping)/n + damping*dangling/n
+		for node := range ranks {
+			next[node] = base
+		}
+		for node, rank := range ranks {
+			outgoing := nodeToOutgoing[node]
+			for _, target := range outgoing {
+				next[target] += damping * rank / float64(len(outgoing))
+			}
+		}
+
+		change := 0.0
+		for node, rank := range next {
+			change += math.Abs(rank - ranks[node])
+		}
+		ranks, next = next, ranks
+		if change < tolerance {
+			break
+		}
+	}
+	return ranks
+}
@@ -0,0 +1,360 @@
+package graph_test
+
+import (
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+func TestDegreeCentrality(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		g    *graph.Graph[int]
+		want map[int]float64
+	}{
+		{
+			name: "empty graph",
+			g:    graph.Undirected[int]().Build(),
+			want: map[int]float64{},
+		},
+		{
+			name: "single node",
+			g:    singleNodeGraph(),
+			want: map[int]float64{1: 1},
+		},
+		{
+			name: "undirected star",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(1, 3), edgeOf(1, 4)),
+			want: map[int]float64{1: 1, 2: 1.0 / 3, 3: 1.0 / 3, 4: 1.0 / 3},
+		},
+		{
+			name: "directed cycle counts in- and out-degree",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(3, 1)),
+			want: map[int]float64{1: 1, 2: 1, 3: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := graph.DegreeCentrality(tt.g)
+
+			assertCentralities(t, "graph.DegreeCentrality", got, tt.want)
+		})
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		g    *graph.Graph[int]
+		want map[int]float64
+	}{
+		{
+			name: "empty graph",
+			g:    graph.Undirected[int]().Build(),
+			want: map[int]float64{},
+		},
+		{
+			name: "single node",
+			g:    singleNodeGraph(),
+			want: map[int]float64{1: 0},
+		},
+		{
+			name: "undirected path",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: map[int]float64{1: 2.0 / 3, 2: 1, 3: 2.0 / 3},
+		},
+		{
+			name: "directed path follows edge direction",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: map[int]float64{1: 2.0 / 3, 2: 0.5, 3: 0},
+		},
+		{
+			name: "disconnected graph is scaled by the nodes reached",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(3, 4), edgeOf(4, 5)),
+			want: map[int]float64{
+				1: 1.0 / 4,
+				2: 1.0 / 4,
+				3: 2.0 / 3 * 2.0 / 4,
+				4: 2.0 / 4,
+				5: 2.0 / 3 * 2.0 / 4,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := graph.ClosenessCentrality(tt.g)
+
+			assertCentralities(t, "graph.ClosenessCentrality", got, tt.want)
+		})
+	}
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		g    *graph.Graph[int]
+		want map[int]float64
+	}{
+		{
+			name: "empty graph",
+			g:    graph.Undirected[int]().Build(),
+			want: map[int]float64{},
+		},
+		{
+			name: "undirected path",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: map[int]float64{1: 0, 2: 1, 3: 0},
+		},
+		{
+			name: "directed path follows edge direction",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: map[int]float64{1: 0, 2: 0.5, 3: 0},
+		},
+		{
+			name: "undirected star",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(1, 3), edgeOf(1, 4)),
+			want: map[int]float64{1: 1, 2: 0, 3: 0, 4: 0},
+		},
+		{
+			name: "undirected square splits paths equally",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 4),
+				edgeOf(4, 1),
+			),
+			want: map[int]float64{1: 1.0 / 6, 2: 1.0 / 6, 3: 1.0 / 6, 4: 1.0 / 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := graph.BetweennessCentrality(tt.g)
+
+			assertCentralities(t, "graph.BetweennessCentrality", got, tt.want)
+		})
+	}
+
+	t.Run("matches counting shortest paths on random graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(11, 12))
+		for i := range 40 {
+			g := graph.Undirected[int]().AllowsSelfLoops(true).Build()
+			if i%2 == 0 {
+				g = graph.Directed[int]().AllowsSelfLoops(true).Build()
+			}
+			for node := range 10 {
+				g.AddNode(node)
+			}
+			for range 18 {
+				g.PutEdge(r.IntN(10), r.IntN(10))
+			}
+
+			got := graph.BetweennessCentrality(g)
+
+			assertCentralities(t, "graph.BetweennessCentrality", got, bruteForceBetweenness(g))
+		}
+	})
+}
+
+func TestPageRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		g    *graph.Graph[int]
+		want map[int]float64
+	}{
+		{
+			name: "empty graph",
+			g:    graph.Directed[int]().Build(),
+			want: map[int]float64{},
+		},
+		{
+			name: "directed cycle",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(3, 1)),
+			want: map[int]float64{1: 1.0 / 3, 2: 1.0 / 3, 3: 1.0 / 3},
+		},
+		{
+			name: "directed graph",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(1, 3),
+				edgeOf(2, 3),
+				edgeOf(3, 1),
+			),
+			want: map[int]float64{
+				1: 0.3877897117015263,
+				2: 0.2148106274731487,
+				3: 0.3973996608253251,
+			},
+		},
+		{
+			name: "node with no outgoing edges",
+			g:    directedIntGraphOf(edgeOf(1, 2)),
+			want: map[int]float64{1: 0.3508771929824562, 2: 0.6491228070175439},
+		},
+		{
+			name: "undirected path",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: map[int]float64{
+				1: 0.25675675675675674,
+				2: 0.48648648648648624,
+				3: 0.25675675675675674,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := graph.PageRank(tt.g, 0.85, 1e-12)
+
+			assertCentralities(t, "graph.PageRank", got, tt.want)
+		})
+	}
+
+	t.Run("ranks sum to 1", func(t *testing.T) {
+		t.Parallel()
+
+		got := graph.PageRank(randomDirectedGraph(50, 200), 0.85, 1e-9)
+
+		sum := 0.0
+		for _, rank := range got {
+			sum += rank
+		}
+		if math.Abs(sum-1) > 1e-6 {
+			t.Errorf("graph.PageRank: got ranks summing to %v, want 1", sum)
+		}
+	})
+
+	for _, tt := range []struct {
+		name      string
+		damping   float64
+		tolerance float64
+	}{
+		{name: "negative damping", damping: -0.1, tolerance: 1e-6},
+		{name: "damping of 1", damping: 1, tolerance: 1e-6},
+		{name: "NaN damping", damping: math.NaN(), tolerance: 1e-6},
+		{name: "zero tolerance", damping: 0.85, tolerance: 0},
+	} {
+		t.Run("panics for "+tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			defer func() {
+				_ = recover()
+			}()
+
+			graph.PageRank(directedIntGraphOf(edgeOf(1, 2)), tt.damping, tt.tolerance)
+
+			t.Errorf("graph.PageRank: should have panicked")
+		})
+	}
+}
+
+func singleNodeGraph() *graph.Graph[int] {
+	result := graph.Undirected[int]().Build()
+	result.AddNode(1)
+	return result
+}
+
+func assertCentralities(
+	t *testing.T,
+	funcName string,
+	got map[int]float64,
+	want map[int]float64,
+) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Errorf("%s: got %v, want %v", funcName, got, want)
+		return
+	}
+	for node, wantValue := range want {
+		gotValue, ok := got[node]
+		if !ok || math.Abs(gotValue-wantValue) > 1e-9 {
+			t.Errorf("%s: got %v, want %v", funcName, got, want)
+			return
+		}
+	}
+}
+
+// bruteForceBetweenness counts the shortest paths between each pair of nodes
+// and sums, for each node, the fraction of them that go through it.
+func bruteForceBetweenness(g *graph.Graph[int]) map[int]float64 {
+	nodes := slices.Collect(g.Nodes().All())
+	distances := make(map[int]map[int]int)
+	pathCounts := make(map[int]map[int]float64)
+	for _, source := range nodes {
+		distances[source] = graph.Distances(g, source)
+		pathCounts[source] = shortestPathCounts(g, distances[source])
+	}
+
+	result := make(map[int]float64)
+	for _, node := range nodes {
+		result[node] = 0
+		for _, s := range nodes {
+			for _, t := range nodes {
+				if s == node || t == node || s == t {
+					continue
+				}
+				total, ok := distances[s][t]
+				if !ok {
+					continue
+				}
+				toNode, ok1 := distances[s][node]
+				fromNode, ok2 := distances[node][t]
+				if ok1 && ok2 && toNode+fromNode == total {
+					result[node] += pathCounts[s][node] * pathCounts[node][t] /
+						pathCounts[s][t]
+				}
+			}
+		}
+		if n := float64(len(nodes)); n > 2 {
+			result[node] /= (n - 1) * (n - 2)
+		}
+	}
+	return result
+}
+
+// shortestPathCounts returns the number of shortest paths from the node at
+// distance 0 in distances to each node in distances.
+func shortestPathCounts(
+	g *graph.Graph[int],
+	distances map[int]int,
+) map[int]float64 {
+	order := slices.SortedFunc(
+		maps.Keys(distances),
+		func(a, b int) int { return distances[a] - distances[b] },
+	)
+
+	result := map[int]float64{order[0]: 1}
+	for _, node := range order {
+		next := g.AdjacentNodes(node)
+		if g.IsDirected() {
+			next = g.Successors(node)
+		}
+		for target := range next.All() {
+			if distances[target] == distances[node]+1 {
+				result[target] += result[node]
+			}
+		}
+	}
+	return result
+}
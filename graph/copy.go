@@ -0,0 +1,39 @@
+package graph
+
+// Copy returns a new graph with the same nodes and edges as graph g. The new
+// graph is directed and allows self-loops if and only if g does.
+//
+// The returned graph does not reflect later changes to g, nor vice versa.
+func Copy[N comparable](g GraphView[N]) *Graph[N] {
+	return builderLike(g).From(g)
+}
+
+// From returns a new graph built by this builder with the same nodes and edges
+// as graph g.
+//
+// If this builder is undirected but g is directed, then every edge from a
+// node a to a node b in g becomes an undirected edge between a and b. If this
+// builder is directed but g is undirected, then every undirected edge between
+// a node a and a node b in g becomes two edges, one from a to b and one from b
+// to a.
+//
+// From panics with an *EdgeError wrapping ErrSelfLoopNotAllowed if g has a
+// self-loop but this builder disallows self-loops, like Graph.PutEdge.
+//
+// The returned graph does not reflect later changes to g, nor vice versa.
+func (b Builder[N]) From(g GraphView[N]) *Graph[N] {
+	if b.expectedNodeCount == 0 {
+		b.expectedNodeCount = g.Nodes().Len()
+	}
+	result := b.Build()
+	for node := range g.Nodes().All() {
+		result.AddNode(node)
+	}
+
+	for source := range g.Nodes().All() {
+		for target := range g.Successors(source).All() {
+			result.PutEdge(source, target)
+		}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("has the same nodes, edges and properties", func(t *testing.T) {
+		t.Parallel()
+
+		for _, builder := range []graph.Builder[int]{
+			graph.Undirected[int](),
+			graph.Undirected[int]().AllowsSelfLoops(true),
+			graph.Directed[int](),
+			graph.Directed[int]().AllowsSelfLoops(true),
+		} {
+			g := builder.Build()
+			g.PutEdge(1, 2)
+			g.PutEdge(2, 3)
+			g.AddNode(4)
+			if g.AllowsSelfLoops() {
+				g.PutEdge(3, 3)
+			}
+
+			if got := graph.Copy[int](g); !graph.Equal[int](got, g) {
+				t.Errorf("graph.Copy: got %v, want %v", got, g)
+			}
+		}
+	})
+
+	t.Run("is independent of the original graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+		copied := graph.Copy[int](g)
+		g.PutEdge(2, 3)
+		copied.RemoveNode(1)
+
+		if want := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)); !graph.Equal[int](g, want) {
+			t.Errorf("original graph: got %v, want %v", g, want)
+		}
+		want := directedIntGraphOf()
+		want.AddNode(2)
+		if !graph.Equal[int](copied, want) {
+			t.Errorf("graph.Copy: got %v, want %v", copied, want)
+		}
+	})
+
+	t.Run("of a view", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+
+		got := graph.Copy[int](graph.Transpose[int](g))
+
+		if want := directedIntGraphOf(edgeOf(2, 1)); !graph.Equal[int](got, want) {
+			t.Errorf("graph.Copy: got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestBuilderFrom(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directed graph into undirected builder", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 1), edgeOf(2, 3))
+
+		got := graph.Undirected[int]().From(g)
+
+		if want := undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)); !graph.Equal[int](got, want) {
+			t.Errorf("graph.Builder.From: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("undirected graph into directed builder", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2))
+
+		got := graph.Directed[int]().From(g)
+
+		if want := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 1)); !graph.Equal[int](got, want) {
+			t.Errorf("graph.Builder.From: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run(
+		"graph without self-loops into self-loop-allowing builder",
+		func(t *testing.T) {
+			t.Parallel()
+
+			g := directedIntGraphOf(edgeOf(1, 2))
+
+			got := graph.Directed[int]().AllowsSelfLoops(true).From(g)
+
+			if !got.AllowsSelfLoops() {
+				t.Errorf("graph.Builder.From: got a graph that disallows self-loops")
+			}
+			if !got.HasEdgeConnecting(1, 2) {
+				t.Errorf("graph.Builder.From: got %v, want an edge <1 -> 2>", got)
+			}
+		},
+	)
+
+	t.Run(
+		"self-looping graph into self-loop-disallowing builder panics",
+		func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.Directed[int]().AllowsSelfLoops(true).Build()
+			g.PutEdge(1, 1)
+
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, graph.ErrSelfLoopNotAllowed) {
+					t.Errorf(
+						"graph.Builder.From: got panic %v, want %v",
+						err,
+						graph.ErrSelfLoopNotAllowed,
+					)
+				}
+			}()
+			graph.Directed[int]().From(g)
+			t.Errorf("graph.Builder.From: should have panicked")
+		},
+	)
+}
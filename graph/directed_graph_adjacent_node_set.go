@@ -8,7 +8,7 @@ import (
 
 type directedGraphAdjacentNodeSet[N comparable] struct {
 	node     N
-	delegate *Graph[N]
+	delegate GraphView[N]
 }
 
 func (p directedGraphAdjacentNodeSet[N]) Contains(element N) bool {
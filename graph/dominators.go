@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Dominators returns the dominator tree of directed graph g from node entry,
+// like the entry block of a control-flow graph. A node a dominates a node b if
+// every path from entry to b goes through a, so every node dominates itself.
+// The immediate dominator of b is the dominator of b, other than b itself,
+// that is dominated by all of b's other dominators; every node that is
+// reachable from entry has one, except for entry itself.
+//
+// The nodes that are not reachable from entry are not in the tree, and they
+// neither dominate nor are dominated by any node.
+//
+// Dominators returns ErrUndirected if g is undirected. It panics if entry is
+// not in g. It uses the algorithm by Cooper, Harvey and Kennedy, which runs in
+// O(V^2) time in the worst case, but is fast in practice on control-flow
+// graphs.
+//
+// The returned tree is a copy; it does not reflect later changes to g.
+func Dominators[N comparable](
+	g GraphView[N],
+	entry N,
+) (*DominatorTree[N], error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirected
+	}
+	if !g.Nodes().Contains(entry) {
+		panic(fmt.Sprintf("node %v is not an element of this graph", entry))
+	}
+
+	// Number the nodes that are reachable from entry in reverse postorder,
+	// so that entry is 0 and every node comes before its successors, apart
+	// from along back edges.
+	order := reversePostorder(g, entry)
+	nodeToIndex := make(map[N]int, len(order))
+	for i, node := range order {
+		nodeToIndex[node] = i
+	}
+
+	immediateDominators := make([]int, len(order))
+	for i := range immediateDominators {
+		immediateDominators[i] = -1
+	}
+	immediateDominators[0] = 0
+	intersect := func(a int, b int) int {
+		// Walk up the tree from both nodes until they meet; a node's
+		// dominators always come before it in reverse postorder.
+		for a != b {
+			for a > b {
+				a = immediateDominators[a]
+			}
+			for b > a {
+				b = immediateDominators[b]
+			}
+		}
+		return a
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := 1; i < len(order); i++ {
+			dominator := -1
+			for predecessor := range g.Predecessors(order[i]).All() {
+				p, ok := nodeToIndex[predecessor]
+				if !ok || immediateDominators[p] == -1 {
+					// The predecessor is unreachable or not processed yet.
+					continue
+				}
+				if dominator == -1 {
+					dominator = p
+				} else {
+					dominator = intersect(p, dominator)
+				}
+			}
+			if immediateDominators[i] != dominator {
+				immediateDominators[i] = dominator
+				changed = true
+			}
+		}
+	}
+
+	return newDominatorTree(entry, order, immediateDominators), nil
+}
+
+// PostDominators returns the post-dominator tree of directed graph g towards
+// node exit, like the exit block of a control-flow graph. A node a
+// post-dominates a node b if every path from b to exit goes through a.
+//
+// PostDominators is the same as calling Dominators with the Transpose of g,
+// so in the returned tree, Dominates(a, b) reports whether a post-dominates b.
+func PostDominators[N comparable](
+	g GraphView[N],
+	exit N,
+) (*DominatorTree[N], error) {
+	return Dominators[N](Transpose(g), exit)
+}
+
+// DominatorTree is the tree of immediate dominators of a directed graph, as
+// found by Dominators. Its Graph method returns it as a directed graph, with
+// an edge from the immediate dominator of each node to that node.
+type DominatorTree[N comparable] struct {
+	entry                    N
+	nodeToImmediateDominator map[N]N
+	graph                    *ImmutableGraph[N]
+	// nodeToPreorder and nodeToPostorder have the position of each node in a
+	// depth-first search of the tree, so that a dominates b if and only if a
+	// comes before b in preorder and after b in postorder.
+	nodeToPreorder  map[N]int
+	nodeToPostorder map[N]int
+}
+
+func newDominatorTree[N comparable](
+	entry N,
+	order []N,
+	immediateDominators []int,
+) *DominatorTree[N] {
+	result := &DominatorTree[N]{
+		entry:                    entry,
+		nodeToImmediateDominator: make(map[N]N, len(order)-1),
+		nodeToPreorder:           make(map[N]int, len(order)),
+		nodeToPostorder:          make(map[N]int, len(order)),
+	}
+	builder := Directed[N]().Immutable().AddNode(entry)
+	for i, node := range order[1:] {
+		dominator := order[immediateDominators[i+1]]
+		result.nodeToImmediateDominator[node] = dominator
+		builder.PutEdge(dominator, node)
+	}
+	result.graph = builder.Build()
+
+	type frame struct {
+		node     N
+		children []N
+	}
+	result.nodeToPreorder[entry] = 0
+	stack := []frame{{
+		node:     entry,
+		children: slices.Collect(result.graph.Successors(entry).All()),
+	}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if len(top.children) == 0 {
+			result.nodeToPostorder[top.node] = len(result.nodeToPostorder)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		child := top.children[0]
+		top.children = top.children[1:]
+		result.nodeToPreorder[child] = len(result.nodeToPreorder)
+		stack = append(stack, frame{
+			node:     child,
+			children: slices.Collect(result.graph.Successors(child).All()),
+		})
+	}
+	return result
+}
+
+// Entry returns the node that the tree is rooted at.
+func (d *DominatorTree[N]) Entry() N {
+	return d.entry
+}
+
+// ImmediateDominator returns the immediate dominator of the given node and
+// true, or the zero value and false if the node is the entry node or is not
+// reachable from it.
+func (d *DominatorTree[N]) ImmediateDominator(node N) (N, bool) {
+	dominator, ok := d.nodeToImmediateDominator[node]
+	return dominator, ok
+}
+
+// Dominates returns true if node a dominates node b, which is when every path
+// from the entry node to b goes through a. Every node that is reachable from
+// the entry node dominates itself. Returns false if either node is not
+// reachable from the entry node.
+func (d *DominatorTree[N]) Dominates(a N, b N) bool {
+	aPreorder, ok := d.nodeToPreorder[a]
+	if !ok {
+		return false
+	}
+	bPreorder, ok := d.nodeToPreorder[b]
+	if !ok {
+		return false
+	}
+	return aPreorder <= bPreorder && d.nodeToPostorder[b] <= d.nodeToPostorder[a]
+}
+
+// Graph returns the tree as a directed graph with the nodes that are reachable
+// from the entry node and an edge from the immediate dominator of each node to
+// that node. The successors of a node are the nodes that it immediately
+// dominates.
+func (d *DominatorTree[N]) Graph() *ImmutableGraph[N] {
+	return d.graph
+}
+
+// reversePostorder returns the nodes that are reachable from node entry in
+// directed graph g, in the reverse of the order that an iterative depth-first
+// search from entry finishes them.
+func reversePostorder[N comparable](g GraphView[N], entry N) []N {
+	type frame struct {
+		node       N
+		successors []N
+	}
+	visited := map[N]bool{entry: true}
+	stack := []frame{{
+		node:       entry,
+		successors: slices.Collect(g.Successors(entry).All()),
+	}}
+	var result []N
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if len(top.successors) == 0 {
+			result = append(result, top.node)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		next := top.successors[0]
+		top.successors = top.successors[1:]
+		if visited[next] {
+			continue
+		}
+		visited[next] = true
+		stack = append(stack, frame{
+			node:       next,
+			successors: slices.Collect(g.Successors(next).All()),
+		})
+	}
+	slices.Reverse(result)
+	return result
+}
@@ -0,0 +1,245 @@
+package graph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+func TestDominators(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finds the immediate dominators of a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		tree, err := graph.Dominators(lengauerTarjanGraph(), "R")
+		if err != nil {
+			t.Fatalf("graph.Dominators: got error %v, want nil", err)
+		}
+
+		want := map[string]string{
+			"A": "R",
+			"B": "R",
+			"C": "R",
+			"D": "R",
+			"E": "R",
+			"F": "C",
+			"G": "C",
+			"H": "R",
+			"I": "R",
+			"J": "G",
+			"K": "R",
+			"L": "D",
+		}
+		for node, wantDominator := range want {
+			if got, ok := tree.ImmediateDominator(node); !ok || got != wantDominator {
+				t.Errorf(
+					"DominatorTree.ImmediateDominator(%q): got (%q, %t), want (%q, true)",
+					node,
+					got,
+					ok,
+					wantDominator,
+				)
+			}
+		}
+		if got, ok := tree.ImmediateDominator("R"); ok {
+			t.Errorf(
+				"DominatorTree.ImmediateDominator(\"R\"): got (%q, %t), want (\"\", false)",
+				got,
+				ok,
+			)
+		}
+		if got := tree.Entry(); got != "R" {
+			t.Errorf("DominatorTree.Entry: got %q, want \"R\"", got)
+		}
+	})
+
+	t.Run("returns the tree as a graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(
+			edgeOf(1, 2),
+			edgeOf(2, 3),
+			edgeOf(2, 4),
+			edgeOf(2, 6),
+			edgeOf(3, 5),
+			edgeOf(4, 5),
+			edgeOf(5, 2),
+		)
+		g.AddNode(7)
+
+		tree, err := graph.Dominators(g, 1)
+		if err != nil {
+			t.Fatalf("graph.Dominators: got error %v, want nil", err)
+		}
+
+		want := directedIntGraphOf(
+			edgeOf(1, 2),
+			edgeOf(2, 3),
+			edgeOf(2, 4),
+			edgeOf(2, 5),
+			edgeOf(2, 6),
+		)
+		if got := tree.Graph(); !graph.Equal[int](got, want) {
+			t.Errorf("DominatorTree.Graph: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("answers dominance queries", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(
+			edgeOf(1, 2),
+			edgeOf(2, 3),
+			edgeOf(2, 4),
+			edgeOf(3, 5),
+			edgeOf(4, 5),
+			edgeOf(8, 5),
+		)
+
+		tree, err := graph.Dominators(g, 1)
+		if err != nil {
+			t.Fatalf("graph.Dominators: got error %v, want nil", err)
+		}
+
+		for _, tt := range []struct {
+			a, b int
+			want bool
+		}{
+			{a: 1, b: 5, want: true},
+			{a: 2, b: 5, want: true},
+			{a: 3, b: 5, want: false},
+			{a: 5, b: 2, want: false},
+			{a: 4, b: 4, want: true},
+			{a: 8, b: 8, want: false},
+			{a: 8, b: 5, want: false},
+			{a: 1, b: nodeNotInGraph, want: false},
+		} {
+			if got := tree.Dominates(tt.a, tt.b); got != tt.want {
+				t.Errorf("DominatorTree.Dominates(%d, %d): got %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("matches removing each node on random graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(9, 10))
+		for range 30 {
+			g := graph.Directed[int]().AllowsSelfLoops(true).Build()
+			g.AddNode(0)
+			for range 25 {
+				g.PutEdge(r.IntN(12), r.IntN(12))
+			}
+
+			tree, err := graph.Dominators(g, 0)
+			if err != nil {
+				t.Fatalf("graph.Dominators: got error %v, want nil", err)
+			}
+
+			reachable := graph.ReachableNodes(g, 0)
+			for a := range g.Nodes().All() {
+				without := graph.Filter(g, func(n int) bool { return n != a }, nil)
+				var reachableWithout graph.SetView[int]
+				if a != 0 {
+					reachableWithout = graph.ReachableNodes(without, 0)
+				}
+				for b := range g.Nodes().All() {
+					// a dominates b if b is reachable, but not once a is
+					// removed.
+					want := reachable.Contains(b) &&
+						(a == b || a == 0 || !reachableWithout.Contains(b))
+					if got := tree.Dominates(a, b); got != want {
+						t.Errorf(
+							"DominatorTree.Dominates(%d, %d): got %t, want %t for graph %v",
+							a,
+							b,
+							got,
+							want,
+							g,
+						)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("returns an error for an undirected graph", func(t *testing.T) {
+		t.Parallel()
+
+		tree, err := graph.Dominators(undirectedIntGraphOf(edgeOf(1, 2)), 1)
+
+		if !errors.Is(err, graph.ErrUndirected) {
+			t.Errorf(
+				"graph.Dominators: got (%v, %v), want error %v",
+				tree,
+				err,
+				graph.ErrUndirected,
+			)
+		}
+	})
+
+	t.Run("panics on an absent entry node", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() { _ = recover() }()
+		_, _ = graph.Dominators(directedIntGraphOf(edgeOf(1, 2)), nodeNotInGraph)
+		t.Errorf("graph.Dominators: should have panicked")
+	})
+}
+
+func TestPostDominators(t *testing.T) {
+	t.Parallel()
+
+	// An if-else: 1 branches to 2 and 3, which both go on to 4, then 5 is
+	// the exit.
+	g := directedIntGraphOf(
+		edgeOf(1, 2),
+		edgeOf(1, 3),
+		edgeOf(2, 4),
+		edgeOf(3, 4),
+		edgeOf(4, 5),
+	)
+
+	tree, err := graph.PostDominators(g, 5)
+	if err != nil {
+		t.Fatalf("graph.PostDominators: got error %v, want nil", err)
+	}
+
+	if got, ok := tree.ImmediateDominator(1); !ok || got != 4 {
+		t.Errorf("DominatorTree.ImmediateDominator(1): got (%d, %t), want (4, true)", got, ok)
+	}
+	if !tree.Dominates(4, 2) {
+		t.Errorf("DominatorTree.Dominates(4, 2): got false, want true")
+	}
+	if tree.Dominates(2, 1) {
+		t.Errorf("DominatorTree.Dominates(2, 1): got true, want false")
+	}
+}
+
+// lengauerTarjanGraph returns the directed graph that is used to demonstrate
+// dominators in "A Fast Algorithm for Finding Dominators in a Flowgraph" by
+// Lengauer and Tarjan.
+func lengauerTarjanGraph() *graph.Graph[string] {
+	g := graph.Directed[string]().Build()
+	for _, edge := range [][2]string{
+		{"R", "A"}, {"R", "B"}, {"R", "C"},
+		{"A", "D"},
+		{"B", "A"}, {"B", "D"}, {"B", "E"},
+		{"C", "F"}, {"C", "G"},
+		{"D", "L"},
+		{"E", "H"},
+		{"F", "I"},
+		{"G", "I"}, {"G", "J"},
+		{"H", "E"}, {"H", "K"},
+		{"I", "K"},
+		{"J", "I"},
+		{"K", "I"}, {"K", "R"},
+		{"L", "H"},
+	} {
+		g.PutEdge(edge[0], edge[1])
+	}
+	return g
+}
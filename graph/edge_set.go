@@ -7,7 +7,7 @@ import (
 )
 
 type edgeSet[N comparable] struct {
-	delegate *Graph[N]
+	delegate GraphView[N]
 	len      func() int
 }
 
@@ -0,0 +1,240 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// ElementOrder is the order in which the elements of a graph, like its nodes
+// or the neighbors of a node, are returned when iterated over. It is passed
+// to Builder.NodeOrder and Builder.IncidentEdgeOrder.
+//
+// The zero value is the same as Unordered.
+type ElementOrder[N comparable] struct {
+	newSet func(capacity int) mutableSet[N]
+}
+
+// Unordered returns an ElementOrder with no guaranteed order; the elements may
+// even be returned in a different order from one iteration to the next. This
+// is the fastest order and the one that uses the least memory.
+func Unordered[N comparable]() ElementOrder[N] {
+	return ElementOrder[N]{}
+}
+
+// Insertion returns an ElementOrder in which the elements are returned in the
+// order that they were first added. An element that is removed and added again
+// is returned last.
+func Insertion[N comparable]() ElementOrder[N] {
+	return ElementOrder[N]{
+		newSet: func(capacity int) mutableSet[N] {
+			return newInsertionOrderedSet[N](capacity)
+		},
+	}
+}
+
+// Sorted returns an ElementOrder in which the elements are returned in
+// ascending order according to compare, which returns a negative number when
+// a < b, a positive number when a > b and zero when a == b, like cmp.Compare.
+//
+// compare must only return zero for elements that are equal according to ==.
+//
+// Adding and removing elements takes O(n) time with this order, where n is the
+// number of elements in the set being changed.
+func Sorted[N comparable](compare func(a, b N) int) ElementOrder[N] {
+	return ElementOrder[N]{
+		newSet: func(capacity int) mutableSet[N] {
+			return newSortedSet(compare, capacity)
+		},
+	}
+}
+
+func (o ElementOrder[N]) newMutableSet(capacity int) mutableSet[N] {
+	if o.newSet == nil {
+		return set.WithCapacity[N](capacity)
+	}
+	return o.newSet(capacity)
+}
+
+// mutableSet is the subset of set.Set's methods that Graph uses to store its
+// nodes and the neighbors of each node.
+type mutableSet[N comparable] interface {
+	SetView[N]
+	Add(element N, others ...N) bool
+	Remove(element N, others ...N) bool
+}
+
+type insertionOrderedSet[T comparable] struct {
+	elementToEntry map[T]*insertionOrderedSetEntry[T]
+	head           *insertionOrderedSetEntry[T]
+	tail           *insertionOrderedSetEntry[T]
+}
+
+type insertionOrderedSetEntry[T comparable] struct {
+	element  T
+	previous *insertionOrderedSetEntry[T]
+	next     *insertionOrderedSetEntry[T]
+}
+
+func newInsertionOrderedSet[T comparable](capacity int) *insertionOrderedSet[T] {
+	return &insertionOrderedSet[T]{
+		elementToEntry: make(map[T]*insertionOrderedSetEntry[T], capacity),
+	}
+}
+
+func (s *insertionOrderedSet[T]) Contains(element T) bool {
+	_, ok := s.elementToEntry[element]
+	return ok
+}
+
+func (s *insertionOrderedSet[T]) Len() int {
+	return len(s.elementToEntry)
+}
+
+func (s *insertionOrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// The next entry is read before yielding so that the element being
+		// yielded can be removed by the caller without ending the iteration.
+		for entry := s.head; entry != nil; {
+			next := entry.next
+			if !yield(entry.element) {
+				return
+			}
+			entry = next
+		}
+	}
+}
+
+func (s *insertionOrderedSet[T]) String() string {
+	return set.StringImpl[T](s)
+}
+
+func (s *insertionOrderedSet[T]) Add(element T, others ...T) bool {
+	result := s.addInternal(element)
+	for _, other := range others {
+		result = s.addInternal(other) || result
+	}
+	return result
+}
+
+func (s *insertionOrderedSet[T]) addInternal(element T) bool {
+	if s.Contains(element) {
+		return false
+	}
+
+	entry := &insertionOrderedSetEntry[T]{
+		element:  element,
+		previous: s.tail,
+	}
+	if s.tail == nil {
+		s.head = entry
+	} else {
+		s.tail.next = entry
+	}
+	s.tail = entry
+	s.elementToEntry[element] = entry
+	return true
+}
+
+func (s *insertionOrderedSet[T]) Remove(element T, others ...T) bool {
+	result := s.removeInternal(element)
+	for _, other := range others {
+		result = s.removeInternal(other) || result
+	}
+	return result
+}
+
+func (s *insertionOrderedSet[T]) removeInternal(element T) bool {
+	entry, ok := s.elementToEntry[element]
+	if !ok {
+		return false
+	}
+
+	if entry.previous == nil {
+		s.head = entry.next
+	} else {
+		entry.previous.next = entry.next
+	}
+	if entry.next == nil {
+		s.tail = entry.previous
+	} else {
+		entry.next.previous = entry.previous
+	}
+	delete(s.elementToEntry, element)
+	return true
+}
+
+type sortedSet[T comparable] struct {
+	elements []T
+	compare  func(a, b T) int
+}
+
+func newSortedSet[T comparable](
+	compare func(a, b T) int,
+	capacity int,
+) *sortedSet[T] {
+	return &sortedSet[T]{
+		elements: make([]T, 0, capacity),
+		compare:  compare,
+	}
+}
+
+func (s *sortedSet[T]) Contains(element T) bool {
+	_, found := slices.BinarySearchFunc(s.elements, element, s.compare)
+	return found
+}
+
+func (s *sortedSet[T]) Len() int {
+	return len(s.elements)
+}
+
+func (s *sortedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, element := range s.elements {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+func (s *sortedSet[T]) String() string {
+	return set.StringImpl[T](s)
+}
+
+func (s *sortedSet[T]) Add(element T, others ...T) bool {
+	result := s.addInternal(element)
+	for _, other := range others {
+		result = s.addInternal(other) || result
+	}
+	return result
+}
+
+func (s *sortedSet[T]) addInternal(element T) bool {
+	index, found := slices.BinarySearchFunc(s.elements, element, s.compare)
+	if found {
+		return false
+	}
+
+	s.elements = slices.Insert(s.elements, index, element)
+	return true
+}
+
+func (s *sortedSet[T]) Remove(element T, others ...T) bool {
+	result := s.removeInternal(element)
+	for _, other := range others {
+		result = s.removeInternal(other) || result
+	}
+	return result
+}
+
+func (s *sortedSet[T]) removeInternal(element T) bool {
+	index, found := slices.BinarySearchFunc(s.elements, element, s.compare)
+	if !found {
+		return false
+	}
+
+	s.elements = slices.Delete(s.elements, index, index+1)
+	return true
+}
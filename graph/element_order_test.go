@@ -0,0 +1,243 @@
+package graph_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/graph/graphtest"
+)
+
+func TestOrderedGraph(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name          string
+		builder       graph.Builder[int]
+		directionMode graphtest.DirectionMode
+		selfLoopsMode graphtest.SelfLoopsMode
+	}
+	var tests []testCase
+	for _, order := range []struct {
+		name  string
+		order graph.ElementOrder[int]
+	}{
+		{name: "insertion order", order: graph.Insertion[int]()},
+		{name: "sorted order", order: graph.Sorted(cmp.Compare[int])},
+	} {
+		tests = append(
+			tests,
+			testCase{
+				name: "undirected allowing self-loops in " + order.name,
+				builder: graph.Undirected[int]().
+					AllowsSelfLoops(true).
+					NodeOrder(order.order).
+					IncidentEdgeOrder(order.order),
+				directionMode: graphtest.Undirected,
+				selfLoopsMode: graphtest.AllowsSelfLoops,
+			},
+			testCase{
+				name: "directed allowing self-loops in " + order.name,
+				builder: graph.Directed[int]().
+					AllowsSelfLoops(true).
+					NodeOrder(order.order).
+					IncidentEdgeOrder(order.order),
+				directionMode: graphtest.Directed,
+				selfLoopsMode: graphtest.AllowsSelfLoops,
+			},
+		)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			graphtest.TestMutable(
+				t,
+				func() graphtest.MutableGraph[int] {
+					return tt.builder.Build()
+				},
+				tt.directionMode,
+				tt.selfLoopsMode,
+			)
+		})
+	}
+}
+
+func TestBuilderNodeOrder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("insertion order", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().NodeOrder(graph.Insertion[int]()).Build()
+		g.AddNode(3)
+		g.AddNode(1)
+		g.AddNode(2)
+		g.AddNode(4)
+		g.RemoveNode(1)
+		g.AddNode(1)
+
+		assertOrder(t, "Graph.Nodes", g.Nodes(), []int{3, 2, 4, 1})
+	})
+
+	t.Run("sorted order", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().
+			NodeOrder(graph.Sorted(cmp.Compare[int])).
+			Build()
+		g.AddNode(3)
+		g.AddNode(1)
+		g.AddNode(4)
+		g.AddNode(2)
+		g.RemoveNode(3)
+
+		assertOrder(t, "Graph.Nodes", g.Nodes(), []int{1, 2, 4})
+	})
+
+	t.Run("reverse sorted order", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().
+			NodeOrder(graph.Sorted(func(a, b int) int { return cmp.Compare(b, a) })).
+			Build()
+		g.PutEdge(1, 3)
+		g.PutEdge(2, 4)
+
+		assertOrder(t, "Graph.Nodes", g.Nodes(), []int{4, 3, 2, 1})
+	})
+}
+
+func TestBuilderIncidentEdgeOrder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("insertion order of successors and predecessors", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().
+			IncidentEdgeOrder(graph.Insertion[int]()).
+			Build()
+		g.PutEdge(1, 4)
+		g.PutEdge(1, 2)
+		g.PutEdge(1, 3)
+		g.PutEdge(3, 5)
+		g.PutEdge(2, 5)
+		g.PutEdge(4, 5)
+
+		assertOrder(t, "Graph.Successors", g.Successors(1), []int{4, 2, 3})
+		assertOrder(t, "Graph.Predecessors", g.Predecessors(5), []int{3, 2, 4})
+	})
+
+	t.Run("sorted order of adjacent nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().
+			IncidentEdgeOrder(graph.Sorted(cmp.Compare[int])).
+			Build()
+		g.PutEdge(1, 4)
+		g.PutEdge(1, 2)
+		g.PutEdge(3, 1)
+
+		assertOrder(t, "Graph.AdjacentNodes", g.AdjacentNodes(1), []int{2, 3, 4})
+	})
+
+	t.Run(
+		"directed adjacent nodes are predecessors then successors",
+		func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.Directed[int]().
+				IncidentEdgeOrder(graph.Sorted(cmp.Compare[int])).
+				Build()
+			g.PutEdge(1, 5)
+			g.PutEdge(1, 2)
+			g.PutEdge(4, 1)
+			g.PutEdge(3, 1)
+
+			assertOrder(
+				t,
+				"Graph.AdjacentNodes",
+				g.AdjacentNodes(1),
+				[]int{3, 4, 2, 5},
+			)
+		},
+	)
+
+	t.Run("removed edges are reinserted last", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().
+			IncidentEdgeOrder(graph.Insertion[int]()).
+			Build()
+		g.PutEdge(1, 2)
+		g.PutEdge(1, 3)
+		g.RemoveEdge(1, 2)
+		g.PutEdge(1, 2)
+
+		assertOrder(t, "Graph.AdjacentNodes", g.AdjacentNodes(1), []int{3, 2})
+	})
+}
+
+func TestBuilderEdgeOrder(t *testing.T) {
+	t.Parallel()
+
+	g := graph.Directed[int]().
+		NodeOrder(graph.Insertion[int]()).
+		IncidentEdgeOrder(graph.Sorted(cmp.Compare[int])).
+		Build()
+	g.PutEdge(2, 3)
+	g.PutEdge(2, 1)
+	g.PutEdge(1, 3)
+	g.PutEdge(3, 4)
+
+	assertOrder(
+		t,
+		"Graph.Edges",
+		g.Edges(),
+		[]graph.EndpointPair[int]{
+			edgeOf(2, 1),
+			edgeOf(2, 3),
+			edgeOf(3, 4),
+			edgeOf(1, 3),
+		},
+	)
+	assertOrder(
+		t,
+		"Graph.IncidentEdges",
+		g.IncidentEdges(3),
+		[]graph.EndpointPair[int]{edgeOf(1, 3), edgeOf(2, 3), edgeOf(3, 4)},
+	)
+}
+
+func TestBuilderUnorderedOrder(t *testing.T) {
+	t.Parallel()
+
+	g := graph.Undirected[int]().
+		NodeOrder(graph.Unordered[int]()).
+		IncidentEdgeOrder(graph.Unordered[int]()).
+		Build()
+	g.PutEdge(1, 2)
+	g.PutEdge(2, 3)
+
+	want := undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3))
+	if !graph.Equal[int](g, want) {
+		t.Errorf("graph.Builder.Build: got %v, want %v", g, want)
+	}
+}
+
+func assertOrder[T comparable](
+	t *testing.T,
+	name string,
+	s graph.SetView[T],
+	want []T,
+) {
+	t.Helper()
+
+	if got := slices.Collect(s.All()); !slices.Equal(got, want) {
+		t.Errorf("%s: got %v, want %v", name, got, want)
+	}
+	if got := s.Len(); got != len(want) {
+		t.Errorf("%s.Len: got %d, want %d", name, got, len(want))
+	}
+}
@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The errors returned by this package. They are all comparable with
+// errors.Is, even when they are wrapped by an EdgeError.
+var (
+	// ErrCycle is returned by functions that need an acyclic graph when they
+	// are given a graph with a cycle. Self-loops count as cycles.
+	ErrCycle = errors.New("graph has a cycle")
+
+	// ErrUndirected is returned by functions that need a directed graph when
+	// they are given an undirected graph.
+	ErrUndirected = errors.New("graph is undirected")
+
+	// ErrDirected is returned by functions that need an undirected graph when
+	// they are given a directed graph.
+	ErrDirected = errors.New("graph is directed")
+
+	// ErrNotBipartite is returned by functions that need a bipartite graph
+	// when they are given a graph that is not bipartite.
+	ErrNotBipartite = errors.New("graph is not bipartite")
+
+	// ErrSelfLoopNotAllowed is returned when a self-loop is put into a graph
+	// that disallows self-loops.
+	ErrSelfLoopNotAllowed = errors.New("self-loops are disallowed")
+)
+
+// EdgeError records an error about an edge and the source and target nodes of
+// that edge. Err is one of the errors in this package, like
+// ErrSelfLoopNotAllowed.
+type EdgeError[N comparable] struct {
+	Source N
+	Target N
+	Err    error
+}
+
+func (e *EdgeError[N]) Error() string {
+	return fmt.Sprintf("edge from %v to %v: %v", e.Source, e.Target, e.Err)
+}
+
+func (e *EdgeError[N]) Unwrap() error {
+	return e.Err
+}
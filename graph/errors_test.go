@@ -0,0 +1,39 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+func TestEdgeError(t *testing.T) {
+	t.Parallel()
+
+	g := graph.Undirected[string]().Build()
+
+	_, err := g.TryPutEdge("a", "a")
+
+	var edgeErr *graph.EdgeError[string]
+	if !errors.As(err, &edgeErr) {
+		t.Fatalf("Graph.TryPutEdge: got error %v, want an *EdgeError", err)
+	}
+	if edgeErr.Source != "a" || edgeErr.Target != "a" {
+		t.Errorf(
+			"EdgeError: got source %q and target %q, want \"a\" and \"a\"",
+			edgeErr.Source,
+			edgeErr.Target,
+		)
+	}
+	if !errors.Is(err, graph.ErrSelfLoopNotAllowed) {
+		t.Errorf(
+			"Graph.TryPutEdge: got error %v, want %v",
+			err,
+			graph.ErrSelfLoopNotAllowed,
+		)
+	}
+	want := "edge from a to a: self-loops are disallowed"
+	if got := err.Error(); got != want {
+		t.Errorf("EdgeError.Error: got %q, want %q", got, want)
+	}
+}
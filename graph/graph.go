@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"fmt"
 	"iter"
 	"slices"
 	"strconv"
@@ -23,8 +24,12 @@ func Directed[N comparable]() Builder[N] {
 }
 
 type Builder[N comparable] struct {
-	directed        bool
-	allowsSelfLoops bool
+	nodeOrder         ElementOrder[N]
+	incidentEdgeOrder ElementOrder[N]
+	expectedNodeCount int
+	expectedDegree    int
+	directed          bool
+	allowsSelfLoops   bool
 }
 
 func (b Builder[N]) AllowsSelfLoops(allowsSelfLoops bool) Builder[N] {
@@ -32,15 +37,74 @@ func (b Builder[N]) AllowsSelfLoops(allowsSelfLoops bool) Builder[N] {
 	return b
 }
 
+// NodeOrder returns a copy of this builder that builds graphs whose Nodes are
+// iterated over in the given order. Edges follows the same order for the
+// source nodes of the edges it returns. The default is Unordered.
+func (b Builder[N]) NodeOrder(order ElementOrder[N]) Builder[N] {
+	b.nodeOrder = order
+	return b
+}
+
+// IncidentEdgeOrder returns a copy of this builder that builds graphs whose
+// Successors, Predecessors and AdjacentNodes are iterated over in the given
+// order. IncidentEdges, and Edges for the edges from each source node, follow
+// the same order. The default is Unordered.
+//
+// For directed graphs, AdjacentNodes returns the predecessors of a node in
+// this order, followed by the successors that are not also predecessors in
+// this order.
+func (b Builder[N]) IncidentEdgeOrder(order ElementOrder[N]) Builder[N] {
+	b.incidentEdgeOrder = order
+	return b
+}
+
+// ExpectedNodeCount returns a copy of this builder that builds graphs with
+// enough space for at least the given number of nodes before they need to
+// grow. It is only a hint; the graphs can still have any number of nodes.
+//
+// ExpectedNodeCount panics if expectedNodeCount is negative.
+func (b Builder[N]) ExpectedNodeCount(expectedNodeCount int) Builder[N] {
+	if expectedNodeCount < 0 {
+		panic(fmt.Sprintf(
+			"expected node count must be non-negative, but was %d",
+			expectedNodeCount,
+		))
+	}
+	b.expectedNodeCount = expectedNodeCount
+	return b
+}
+
+// ExpectedDegree returns a copy of this builder that builds graphs with enough
+// space for at least the given number of successors and predecessors per
+// node before they need to grow. It is only a hint; the nodes can still have
+// any degree.
+//
+// ExpectedDegree panics if expectedDegree is negative.
+func (b Builder[N]) ExpectedDegree(expectedDegree int) Builder[N] {
+	if expectedDegree < 0 {
+		panic(fmt.Sprintf(
+			"expected degree must be non-negative, but was %d",
+			expectedDegree,
+		))
+	}
+	b.expectedDegree = expectedDegree
+	return b
+}
+
 func (b Builder[N]) Build() *Graph[N] {
+	newSet := func() mutableSet[N] {
+		return b.incidentEdgeOrder.newMutableSet(b.expectedDegree)
+	}
+
 	if b.directed {
 		return &Graph[N]{
 			directed:        true,
 			allowsSelfLoops: b.allowsSelfLoops,
-			nodes:           set.Of[N](),
+			nodes:           b.nodeOrder.newMutableSet(b.expectedNodeCount),
 			connections: directedConnections[N]{
-				nodeToPredecessors: make(map[N]set.Set[N]),
-				nodeToSuccessors:   make(map[N]set.Set[N]),
+				nodeToPredecessors: make(map[N]mutableSet[N], b.expectedNodeCount),
+				nodeToSuccessors:   make(map[N]mutableSet[N], b.expectedNodeCount),
+				newSet:             newSet,
 			},
 			numEdges: 0,
 		}
@@ -49,17 +113,27 @@ func (b Builder[N]) Build() *Graph[N] {
 	return &Graph[N]{
 		directed:        false,
 		allowsSelfLoops: b.allowsSelfLoops,
-		nodes:           set.Of[N](),
+		nodes:           b.nodeOrder.newMutableSet(b.expectedNodeCount),
 		connections: undirectedConnections[N]{
-			nodeToAdjacentNodes: make(map[N]set.Set[N]),
+			nodeToAdjacentNodes: make(map[N]mutableSet[N], b.expectedNodeCount),
+			newSet:              newSet,
 		},
 		numEdges: 0,
 	}
 }
 
+// builderLike returns a builder for graphs that are directed and allow
+// self-loops if and only if g does.
+func builderLike[N comparable](g GraphView[N]) Builder[N] {
+	if g.IsDirected() {
+		return Directed[N]().AllowsSelfLoops(g.AllowsSelfLoops())
+	}
+	return Undirected[N]().AllowsSelfLoops(g.AllowsSelfLoops())
+}
+
 // SetView is a read-only set view; a generic, unordered collection of unique
 // elements that only allows read operations. It is returned by a few of the
-// methods on graph.Graph.
+// methods on GraphView.
 type SetView[T comparable] interface {
 	// Contains returns true if this set contains the given element, otherwise
 	// it returns false.
@@ -71,7 +145,8 @@ type SetView[T comparable] interface {
 	// All returns an iter.Seq that returns each and every element in this set.
 	//
 	// The iteration order is undefined; it may even change from one call to
-	// the next.
+	// the next. Graphs made by a Builder with a NodeOrder or
+	// IncidentEdgeOrder define the order of the sets that they return.
 	All() iter.Seq[T]
 
 	// String returns a string representation of all the elements in this set.
@@ -85,9 +160,119 @@ type SetView[T comparable] interface {
 	String() string
 }
 
+// GraphView is a read-only graph; a set of nodes and a set of edges that
+// connect pairs of those nodes. It is implemented by Graph and by the views
+// returned by functions like Transpose and Filter, and it is accepted by all
+// the functions in this package that read graphs, so views can be used
+// wherever a Graph can.
+//
+// Unless stated otherwise, the set views returned by a GraphView's methods
+// reflect later changes to the graph.
+type GraphView[N comparable] interface {
+	// IsDirected returns true if each edge in this graph is directed, pointing
+	// from its source node to its target node, otherwise it returns false.
+	IsDirected() bool
+
+	// AllowsSelfLoops returns true if this graph allows an edge to connect a
+	// node to itself, otherwise it returns false.
+	AllowsSelfLoops() bool
+
+	// Nodes returns all the nodes in this graph.
+	Nodes() SetView[N]
+
+	// Edges returns all the edges in this graph. If this graph is undirected,
+	// then the returned set contains each edge in both directions, but it
+	// only returns each edge in one direction when iterated over.
+	Edges() SetView[EndpointPair[N]]
+
+	// AdjacentNodes returns the nodes that share an edge with the given node,
+	// in either direction.
+	AdjacentNodes(node N) SetView[N]
+
+	// Predecessors returns the nodes that have an edge pointing to the given
+	// node. If this graph is undirected, it is the same as AdjacentNodes.
+	Predecessors(node N) SetView[N]
+
+	// Successors returns the nodes that the given node has an edge pointing
+	// to. If this graph is undirected, it is the same as AdjacentNodes.
+	Successors(node N) SetView[N]
+
+	// IncidentEdges returns the edges that touch the given node.
+	IncidentEdges(node N) SetView[EndpointPair[N]]
+
+	// Degree returns the number of times that an edge touches the given node,
+	// so a self-loop counts twice.
+	Degree(node N) int
+
+	// InDegree returns the number of edges pointing to the given node. If
+	// this graph is undirected, it is the same as Degree.
+	InDegree(node N) int
+
+	// OutDegree returns the number of edges pointing away from the given
+	// node. If this graph is undirected, it is the same as Degree.
+	OutDegree(node N) int
+
+	// HasEdgeConnecting returns true if there is an edge from source to
+	// target in this graph, otherwise it returns false. If this graph is
+	// undirected, the order of source and target does not matter.
+	HasEdgeConnecting(source N, target N) bool
+
+	// HasEdgeConnectingEndpoints is like HasEdgeConnecting, but for the source
+	// and target of the given endpoint pair.
+	HasEdgeConnectingEndpoints(endpointPair EndpointPair[N]) bool
+
+	// String returns a string representation of this graph, in the format
+	// "isDirected: <IsDirected>, allowsSelfLoops: <AllowsSelfLoops>, nodes:
+	// <Nodes>, edges: <Edges>".
+	//
+	// This method satisfies fmt.Stringer.
+	String() string
+}
+
+// MutableGraph is a GraphView with additional methods for adding and removing
+// nodes and edges. It is implemented by Graph.
+type MutableGraph[N comparable] interface {
+	GraphView[N]
+
+	// AddNode adds the given node to this graph. Returns true if this graph
+	// changed as a result of this call, otherwise false.
+	AddNode(node N) bool
+
+	// PutEdge adds an edge from source to target to this graph, adding the
+	// nodes too if they are not already present. Returns true if this graph
+	// changed as a result of this call, otherwise false.
+	//
+	// PutEdge panics if source and target are the same node and this graph
+	// disallows self-loops.
+	PutEdge(source N, target N) bool
+
+	// RemoveNode removes the given node and all of its incident edges from
+	// this graph. Returns true if this graph changed as a result of this
+	// call, otherwise false.
+	RemoveNode(node N) bool
+
+	// RemoveEdge removes the edge from source to target from this graph, but
+	// not the nodes themselves. Returns true if this graph changed as a
+	// result of this call, otherwise false.
+	RemoveEdge(source N, target N) bool
+}
+
+// TryPutEdger is implemented by mutable graphs that can report a disallowed
+// self-loop as an error instead of panicking, like Graph. It is separate from
+// MutableGraph so that MutableGraph implementations written before it existed
+// keep compiling; type assert a MutableGraph to TryPutEdger to find out if it
+// supports TryPutEdge.
+type TryPutEdger[N comparable] interface {
+	// TryPutEdge is like MutableGraph.PutEdge, but it returns an error
+	// instead of panicking. If source and target are the same node and this
+	// graph disallows self-loops, it returns an *EdgeError wrapping
+	// ErrSelfLoopNotAllowed and leaves this graph unchanged.
+	TryPutEdge(source N, target N) (bool, error)
+}
+
 type Graph[N comparable] struct {
 	connections     connections[N]
-	nodes           set.Set[N]
+	nodes           mutableSet[N]
 	numEdges        int
 	directed        bool
 	allowsSelfLoops bool
@@ -113,7 +298,11 @@ func (g *Graph[N]) Edges() SetView[EndpointPair[N]] {
 }
 
 func (g *Graph[N]) AdjacentNodes(node N) SetView[N] {
-	if g.directed {
+	return adjacentNodes[N](g, node)
+}
+
+func adjacentNodes[N comparable](g GraphView[N], node N) SetView[N] {
+	if g.IsDirected() {
 		return directedGraphAdjacentNodeSet[N]{
 			node:     node,
 			delegate: g,
@@ -139,30 +328,42 @@ func (g *Graph[N]) IncidentEdges(node N) SetView[EndpointPair[N]] {
 }
 
 func (g *Graph[N]) Degree(node N) int {
-	if g.directed {
+	return degree[N](g, node)
+}
+
+func (g *Graph[N]) InDegree(node N) int {
+	return inDegree[N](g, node)
+}
+
+func (g *Graph[N]) OutDegree(node N) int {
+	return outDegree[N](g, node)
+}
+
+func degree[N comparable](g GraphView[N], node N) int {
+	if g.IsDirected() {
 		return g.InDegree(node) + g.OutDegree(node)
 	}
 
-	return g.undirectedGraphDegree(node)
+	return undirectedGraphDegree(g, node)
 }
 
-func (g *Graph[N]) InDegree(node N) int {
-	if g.directed {
+func inDegree[N comparable](g GraphView[N], node N) int {
+	if g.IsDirected() {
 		return g.Predecessors(node).Len()
 	}
 
-	return g.undirectedGraphDegree(node)
+	return undirectedGraphDegree(g, node)
 }
 
-func (g *Graph[N]) OutDegree(node N) int {
-	if g.directed {
+func outDegree[N comparable](g GraphView[N], node N) int {
+	if g.IsDirected() {
 		return g.Successors(node).Len()
 	}
 
-	return g.undirectedGraphDegree(node)
+	return undirectedGraphDegree(g, node)
 }
 
-func (g *Graph[N]) undirectedGraphDegree(node N) int {
+func undirectedGraphDegree[N comparable](g GraphView[N], node N) int {
 	selfLoop := g.AdjacentNodes(node).Contains(node)
 	selfLoopCorrection := 0
 	if selfLoop {
@@ -180,6 +381,10 @@ func (g *Graph[N]) HasEdgeConnectingEndpoints(endpointPair EndpointPair[N]) bool
 }
 
 func (g *Graph[N]) String() string {
+	return stringOf[N](g)
+}
+
+func stringOf[N comparable](g GraphView[N]) string {
 	return "isDirected: " +
 		strconv.FormatBool(g.IsDirected()) +
 		", allowsSelfLoops: " +
@@ -195,8 +400,20 @@ func (g *Graph[N]) AddNode(node N) bool {
 }
 
 func (g *Graph[N]) PutEdge(source N, target N) bool {
+	put, err := g.TryPutEdge(source, target)
+	if err != nil {
+		panic(err)
+	}
+	return put
+}
+
+func (g *Graph[N]) TryPutEdge(source N, target N) (bool, error) {
 	if !g.AllowsSelfLoops() && source == target {
-		panic("self-loops are disallowed")
+		return false, &EdgeError[N]{
+			Source: source,
+			Target: target,
+			Err:    ErrSelfLoopNotAllowed,
+		}
 	}
 
 	g.AddNode(source)
@@ -205,10 +422,10 @@ func (g *Graph[N]) PutEdge(source N, target N) bool {
 	put := g.connections.PutEdge(source, target)
 	if put {
 		g.numEdges++
-		return true
+		return true, nil
 	}
 
-	return false
+	return false, nil
 }
 
 func (g *Graph[N]) RemoveNode(node N) bool {
@@ -237,7 +454,8 @@ type connections[N comparable] interface {
 }
 
 type undirectedConnections[N comparable] struct {
-	nodeToAdjacentNodes map[N]set.Set[N]
+	nodeToAdjacentNodes map[N]mutableSet[N]
+	newSet              func() mutableSet[N]
 }
 
 func (u undirectedConnections[N]) adjacentNodes(node N) SetView[N] {
@@ -256,13 +474,13 @@ func (u undirectedConnections[N]) Successors(node N) SetView[N] {
 }
 
 func (u undirectedConnections[N]) PutEdge(source N, target N) bool {
-	put := putConnection(u.nodeToAdjacentNodes, source, target)
-	putConnection(u.nodeToAdjacentNodes, target, source)
+	put := putConnection(u.nodeToAdjacentNodes, source, target, u.newSet)
+	putConnection(u.nodeToAdjacentNodes, target, source, u.newSet)
 	return put
 }
 
 func (u undirectedConnections[N]) RemoveNode(node N) {
-	for adjNode := range u.adjacentNodes(node).All() {
+	for _, adjNode := range copyOf(u.adjacentNodes(node)) {
 		removeConnection(u.nodeToAdjacentNodes, adjNode, node)
 	}
 
@@ -276,8 +494,9 @@ func (u undirectedConnections[N]) RemoveEdge(source N, target N) bool {
 }
 
 type directedConnections[N comparable] struct {
-	nodeToPredecessors map[N]set.Set[N]
-	nodeToSuccessors   map[N]set.Set[N]
+	nodeToPredecessors map[N]mutableSet[N]
+	nodeToSuccessors   map[N]mutableSet[N]
+	newSet             func() mutableSet[N]
 }
 
 func (d directedConnections[N]) Predecessors(node N) SetView[N] {
@@ -295,8 +514,8 @@ func (d directedConnections[N]) Successors(node N) SetView[N] {
 }
 
 func (d directedConnections[N]) PutEdge(source N, target N) bool {
-	put := putConnection(d.nodeToPredecessors, target, source)
-	putConnection(d.nodeToSuccessors, source, target)
+	put := putConnection(d.nodeToPredecessors, target, source, d.newSet)
+	putConnection(d.nodeToSuccessors, source, target, d.newSet)
 	return put
 }
 
@@ -325,16 +544,20 @@ func copyOf[T comparable](s SetView[T]) []T {
 	return slices.Collect(s.All())
 }
 
-func putConnection[N comparable](nodeToNeighbors map[N]set.Set[N], from, to N) bool {
+func putConnection[N comparable](
+	nodeToNeighbors map[N]mutableSet[N],
+	from, to N,
+	newSet func() mutableSet[N],
+) bool {
 	neighbors, ok := nodeToNeighbors[from]
 	if !ok {
-		neighbors = set.Of[N]()
+		neighbors = newSet()
 		nodeToNeighbors[from] = neighbors
 	}
 	return neighbors.Add(to)
 }
 
-func removeConnection[N comparable](nodeToNeighbors map[N]set.Set[N], from, to N) bool {
+func removeConnection[N comparable](nodeToNeighbors map[N]mutableSet[N], from, to N) bool {
 	neighbors, ok := nodeToNeighbors[from]
 	if !ok {
 		return false
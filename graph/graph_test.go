@@ -1,6 +1,8 @@
 package graph_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/jbduncan/go-containers/graph"
@@ -58,3 +60,135 @@ func TestDirectedAllowsSelfLoopsGraph(t *testing.T) {
 		graphtest.AllowsSelfLoops,
 	)
 }
+
+func TestGraphWithExpectedSizes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name          string
+		builder       graph.Builder[int]
+		directionMode graphtest.DirectionMode
+	}
+	tests := []testCase{
+		{
+			name: "undirected",
+			builder: graph.Undirected[int]().
+				AllowsSelfLoops(true).
+				ExpectedNodeCount(10).
+				ExpectedDegree(3),
+			directionMode: graphtest.Undirected,
+		},
+		{
+			name: "directed",
+			builder: graph.Directed[int]().
+				AllowsSelfLoops(true).
+				ExpectedNodeCount(10).
+				ExpectedDegree(3),
+			directionMode: graphtest.Directed,
+		},
+		{
+			name: "directed in insertion order",
+			builder: graph.Directed[int]().
+				AllowsSelfLoops(true).
+				NodeOrder(graph.Insertion[int]()).
+				IncidentEdgeOrder(graph.Insertion[int]()).
+				ExpectedNodeCount(10).
+				ExpectedDegree(3),
+			directionMode: graphtest.Directed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			graphtest.TestMutable(
+				t,
+				func() graphtest.MutableGraph[int] {
+					return tt.builder.Build()
+				},
+				tt.directionMode,
+				graphtest.AllowsSelfLoops,
+			)
+		})
+	}
+}
+
+func TestBuilderExpectedNodeCount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panics if negative", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() { _ = recover() }()
+		graph.Directed[int]().ExpectedNodeCount(-1)
+		t.Errorf("graph.Builder.ExpectedNodeCount: should have panicked")
+	})
+
+	t.Run("does not limit the number of nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().ExpectedNodeCount(1).Build()
+		g.PutEdge(1, 2)
+		g.PutEdge(2, 3)
+
+		if got := g.Nodes().Len(); got != 3 {
+			t.Errorf("Graph.Nodes.Len: got %d, want 3", got)
+		}
+	})
+}
+
+func TestBuilderExpectedDegree(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panics if negative", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() { _ = recover() }()
+		graph.Directed[int]().ExpectedDegree(-1)
+		t.Errorf("graph.Builder.ExpectedDegree: should have panicked")
+	})
+
+	t.Run("does not limit the degree of nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().ExpectedDegree(1).Build()
+		g.PutEdge(1, 2)
+		g.PutEdge(1, 3)
+
+		if got := g.OutDegree(1); got != 2 {
+			t.Errorf("Graph.OutDegree: got %d, want 2", got)
+		}
+	})
+}
+
+func BenchmarkGraphBulkLoad(b *testing.B) {
+	for _, nodes := range []int{benchmarkNodes, benchmarkEdges / 100} {
+		degree := benchmarkEdges / nodes
+		edges := slices.Collect(
+			randomDirectedGraph(nodes, benchmarkEdges).Edges().All(),
+		)
+
+		b.Run(fmt.Sprintf("degree=%d/without expected sizes", degree), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				g := graph.Directed[int]().Build()
+				for _, edge := range edges {
+					g.PutEdge(edge.Source(), edge.Target())
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("degree=%d/with expected sizes", degree), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				g := graph.Directed[int]().
+					ExpectedNodeCount(nodes).
+					ExpectedDegree(degree).
+					Build()
+				for _, edge := range edges {
+					g.PutEdge(edge.Source(), edge.Target())
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package graphtest
 
 import (
+	"errors"
 	"slices"
 	"strconv"
 	"testing"
@@ -9,30 +10,18 @@ import (
 	internalsettest "github.com/jbduncan/go-containers/internal/settest"
 )
 
+// Graph is the read-only graph interface that TestReadOnly and TestImmutable
+// test. It has the same methods as graph.GraphView, so every graph.GraphView
+// is a Graph.
 type Graph[N comparable] interface {
-	IsDirected() bool
-	AllowsSelfLoops() bool
-	Nodes() graph.SetView[N]
-	Edges() graph.SetView[graph.EndpointPair[N]]
-	AdjacentNodes(node N) graph.SetView[N]
-	Predecessors(node N) graph.SetView[N]
-	Successors(node N) graph.SetView[N]
-	IncidentEdges(node N) graph.SetView[graph.EndpointPair[N]]
-	Degree(node N) int
-	InDegree(node N) int
-	OutDegree(node N) int
-	HasEdgeConnecting(source N, target N) bool
-	HasEdgeConnectingEndpoints(endpointPair graph.EndpointPair[N]) bool
-	String() string
+	graph.GraphView[N]
 }
 
+// MutableGraph is the mutable graph interface that TestMutable tests. It has
+// the same methods as graph.MutableGraph, so every graph.MutableGraph is a
+// MutableGraph.
 type MutableGraph[N comparable] interface {
-	Graph[N]
-
-	AddNode(node N) bool
-	PutEdge(source N, target N) bool
-	RemoveNode(node N) bool
-	RemoveEdge(source N, target N) bool
+	graph.MutableGraph[N]
 }
 
 const (
@@ -58,17 +47,22 @@ const (
 	DisallowsSelfLoops
 )
 
-// TestReadOnly runs a suite of test cases for read-only Graph implementations.
+// TestReadOnly runs a suite of test cases for graph.GraphView implementations.
 // Graph instances created for testing are to have int nodes.
 //
 // Test cases that should be handled similarly in any graph implementation are
 // included in this function; for example, testing that the Nodes method
 // returns the set of the nodes in the graph. Details of specific
-// implementations of the Graph interface are not tested.
+// implementations of the graph.GraphView interface are not tested.
 //
 // Parameter `emptyGraph` should always return a newly-initialized empty graph
 // with no nodes and no edges. Otherwise, the behaviour of this function is
 // undefined.
+//
+// Parameters `addNode` and `putEdge` should change the given graph in place to
+// add the given node or edge, and return it. Set views returned by the graph
+// are tested to reflect these changes. For immutable graphs, use
+// TestImmutable instead.
 func TestReadOnly(
 	t *testing.T,
 	emptyGraph func() Graph[int],
@@ -85,18 +79,46 @@ func TestReadOnly(
 		addNode,
 		putEdge,
 		false,
+		false,
 		directionMode == Directed,
 		selfLoopsMode == AllowsSelfLoops,
 	).test()
 }
 
-// TestMutable runs a suite of test cases for MutableGraph implementations.
+// TestImmutable runs a suite of test cases for immutable graph.GraphView
+// implementations. It is like TestReadOnly, except that parameters `addNode`
+// and `putEdge` should return a copy of the given graph plus the given node or
+// edge, leaving the given graph unchanged. Set views returned by the given
+// graph are tested to not reflect the copy's changes.
+func TestImmutable(
+	t *testing.T,
+	emptyGraph func() Graph[int],
+	addNode func(g Graph[int], node int) Graph[int],
+	putEdge func(g Graph[int], source int, target int) Graph[int],
+	directionMode DirectionMode,
+	selfLoopsMode SelfLoopsMode,
+) {
+	validate(t, directionMode, selfLoopsMode)
+
+	newTester(
+		t,
+		emptyGraph,
+		addNode,
+		putEdge,
+		false,
+		true,
+		directionMode == Directed,
+		selfLoopsMode == AllowsSelfLoops,
+	).test()
+}
+
+// TestMutable runs a suite of test cases for graph.MutableGraph implementations.
 // MutableGraph instances created for testing are to have int nodes.
 //
 // Test cases that should be handled similarly in any graph implementation are
 // included in this function; for example, testing that the Nodes method
 // returns the set of the nodes in the graph. Details of specific
-// implementations of the MutableGraph interface are not tested.
+// implementations of the graph.MutableGraph interface are not tested.
 //
 // Parameter `emptyGraph` should always return a newly-initialized empty graph
 // with no nodes and no edges. Otherwise, the behaviour of this function is
@@ -123,6 +145,7 @@ func TestMutable(
 			return g
 		},
 		true,
+		false,
 		directionMode == Directed,
 		selfLoopsMode == AllowsSelfLoops,
 	).test()
@@ -156,6 +179,7 @@ func newTester(
 	addNode func(g Graph[int], node int) Graph[int],
 	putEdge func(g Graph[int], source int, target int) Graph[int],
 	mutable bool,
+	returnsCopies bool,
 	directed bool,
 	allowsSelfLoops bool,
 ) *tester {
@@ -165,17 +189,21 @@ func newTester(
 		addNode:         addNode,
 		putEdge:         putEdge,
 		mutable:         mutable,
+		returnsCopies:   returnsCopies,
 		directed:        directed,
 		allowsSelfLoops: allowsSelfLoops,
 	}
 }
 
 type tester struct {
-	t               *testing.T
-	emptyGraph      func() Graph[int]
-	addNode         func(g Graph[int], node int) Graph[int]
-	putEdge         func(g Graph[int], source int, target int) Graph[int]
-	mutable         bool
+	t          *testing.T
+	emptyGraph func() Graph[int]
+	addNode    func(g Graph[int], node int) Graph[int]
+	putEdge    func(g Graph[int], source int, target int) Graph[int]
+	mutable    bool
+	// returnsCopies is true if addNode and putEdge return changed copies of
+	// the given graph instead of changing it in place.
+	returnsCopies   bool
 	directed        bool
 	allowsSelfLoops bool
 }
@@ -220,8 +248,12 @@ func (tt tester) test() {
 		tt.testSelfLoopDisallowingGraph()
 	}
 
-	if tt.mutable && tt.allowsSelfLoops {
-		tt.testMutableSelfLoopingGraph()
+	if tt.mutable {
+		if tt.allowsSelfLoops {
+			tt.testMutableSelfLoopingGraph()
+		} else {
+			tt.testMutableSelfLoopDisallowingGraph()
+		}
 	}
 
 	tt.testStringRepresentations(tt.t)
@@ -269,7 +301,12 @@ func (tt tester) testEmptyGraph() {
 
 			_ = tt.addNode(g, node1)
 
-			testNodeSet(t, graphNodesName, nodes, node1)
+			testNodeSet(
+				t,
+				graphNodesName,
+				nodes,
+				ifChangedInPlace(tt, node1)...,
+			)
 		})
 
 		t.Run(
@@ -280,15 +317,14 @@ func (tt tester) testEmptyGraph() {
 
 				internalsettest.IsMutable(t, graphAdjacentNodesName, adjacentNodes)
 
-				g = tt.putEdge(g, node1, node2)
-				_ = tt.putEdge(g, node3, node1)
+				g2 := tt.putEdge(g, node1, node2)
+				_ = tt.putEdge(g2, node3, node1)
 
 				testNodeSet(
 					t,
 					graphAdjacentNodesName,
 					adjacentNodes,
-					node2,
-					node3,
+					ifChangedInPlace(tt, node2, node3)...,
 				)
 			},
 		)
@@ -301,7 +337,12 @@ func (tt tester) testEmptyGraph() {
 
 			_ = tt.putEdge(g, node2, node1)
 
-			testNodeSet(t, graphPredecessorsName, predecessors, node2)
+			testNodeSet(
+				t,
+				graphPredecessorsName,
+				predecessors,
+				ifChangedInPlace(tt, node2)...,
+			)
 		})
 
 		t.Run("has an unmodifiable successors set view", func(t *testing.T) {
@@ -312,7 +353,12 @@ func (tt tester) testEmptyGraph() {
 
 			_ = tt.putEdge(g, node1, node2)
 
-			testNodeSet(t, graphSuccessorsName, successors, node2)
+			testNodeSet(
+				t,
+				graphSuccessorsName,
+				successors,
+				ifChangedInPlace(tt, node2)...,
+			)
 		})
 
 		t.Run("has an unmodifiable edges set view", func(t *testing.T) {
@@ -323,7 +369,16 @@ func (tt tester) testEmptyGraph() {
 
 			_ = tt.putEdge(g, node1, node2)
 
-			tt.testEdges(t, g, graph.EndpointPairOf(node1, node2))
+			edgeSetTester{
+				t:        t,
+				setName:  graphEdgesName,
+				edges:    edges,
+				directed: tt.directed,
+				expectedEdges: ifChangedInPlace(
+					tt,
+					graph.EndpointPairOf(node1, node2),
+				),
+			}.test()
 		})
 
 		t.Run(
@@ -336,17 +391,34 @@ func (tt tester) testEmptyGraph() {
 
 				_ = tt.putEdge(g, node1, node2)
 
-				tt.testIncidentEdges(
-					t,
-					g,
-					node1,
-					graph.EndpointPairOf(node1, node2),
-				)
+				edgeSetTester{
+					t:        t,
+					setName:  graphIncidentEdgesName,
+					edges:    edges,
+					directed: tt.directed,
+					expectedEdges: ifChangedInPlace(
+						tt,
+						graph.EndpointPairOf(node1, node2),
+					),
+				}.test()
 			},
 		)
 	})
 }
 
+// ifChangedInPlace returns the given elements unless tt's addNode and putEdge
+// return changed copies, in which case it returns no elements.
+//
+// This is because a set view returned by a graph that is changed in place
+// should reflect the change, but a set view returned by an immutable graph
+// should not.
+func ifChangedInPlace[T any](tt tester, elements ...T) []T {
+	if tt.returnsCopies {
+		return nil
+	}
+	return elements
+}
+
 func (tt tester) testGraphWithOneNode() {
 	tt.t.Run("graph with one node", func(t *testing.T) {
 		g := func() Graph[int] {
@@ -606,7 +678,7 @@ func (tt tester) emptyMutableGraph() MutableGraph[int] {
 	mutG, ok := g.(MutableGraph[int])
 	if !ok {
 		tt.t.Fatalf(
-			"graph was expected to implement graphtest.MutableGraph, " +
+			"graph was expected to implement graph.MutableGraph, " +
 				"but it did not")
 		return nil // Make the compiler happy
 	}
@@ -627,6 +699,10 @@ func (tt tester) testMutableGraph() {
 
 		tt.testMutableGraphPuttingExistingEdge(t)
 
+		tt.testMutableGraphTryPuttingNewEdge(t)
+
+		tt.testMutableGraphTryPuttingExistingEdge(t)
+
 		tt.testMutableGraphPuttingTwoAntiParallelEdges(t)
 
 		tt.testMutableGraphRemovingExistingEdge(t)
@@ -742,6 +818,53 @@ func (tt tester) testMutableGraphPuttingExistingEdge(t *testing.T) {
 	})
 }
 
+// tryPutEdge calls TryPutEdge on g if g implements graph.TryPutEdger,
+// otherwise it skips the test.
+func tryPutEdge(
+	t *testing.T,
+	g MutableGraph[int],
+	source int,
+	target int,
+) (bool, error) {
+	t.Helper()
+
+	tryPutter, ok := g.(graph.TryPutEdger[int])
+	if !ok {
+		t.Skip("graph does not implement graph.TryPutEdger")
+	}
+	return tryPutter.TryPutEdge(source, target)
+}
+
+func (tt tester) testMutableGraphTryPuttingNewEdge(t *testing.T) {
+	t.Run("try putting a new edge returns true", func(t *testing.T) {
+		g := tt.emptyMutableGraph()
+
+		got, err := tryPutEdge(t, g, node1, node2)
+		if err != nil {
+			t.Fatalf("TryPutEdger.TryPutEdge: got error %v, want nil", err)
+		}
+		if !got {
+			t.Fatalf("TryPutEdger.TryPutEdge: got false, want true")
+		}
+		tt.testEdges(t, g, graph.EndpointPairOf(node1, node2))
+	})
+}
+
+func (tt tester) testMutableGraphTryPuttingExistingEdge(t *testing.T) {
+	t.Run("try putting an existing edge returns false", func(t *testing.T) {
+		g := tt.emptyMutableGraph()
+		g.PutEdge(node1, node2)
+
+		got, err := tryPutEdge(t, g, node1, node2)
+		if err != nil {
+			t.Fatalf("TryPutEdger.TryPutEdge: got error %v, want nil", err)
+		}
+		if got {
+			t.Fatalf("TryPutEdger.TryPutEdge: got true, want false")
+		}
+	})
+}
+
 func (tt tester) testMutableGraphPuttingTwoAntiParallelEdges(t *testing.T) {
 	t.Run(
 		"putting two anti-parallel edges and removing one of the nodes",
@@ -1085,6 +1208,63 @@ func (tt tester) testMutableSelfLoopingGraph() {
 				tt.testEdges(t, g)
 			},
 		)
+
+		t.Run("try putting a self-loop returns true", func(t *testing.T) {
+			g := tt.emptyMutableGraph()
+
+			got, err := tryPutEdge(t, g, node1, node1)
+			if err != nil {
+				t.Fatalf("TryPutEdger.TryPutEdge: got error %v, want nil", err)
+			}
+			if !got {
+				t.Fatalf("TryPutEdger.TryPutEdge: got false, want true")
+			}
+			if !g.HasEdgeConnecting(node1, node1) {
+				t.Fatalf("Graph.HasEdgeConnecting: got false, want true")
+			}
+		})
+	})
+}
+
+func (tt tester) testMutableSelfLoopDisallowingGraph() {
+	tt.t.Run("mutable self-loop-disallowing graph", func(t *testing.T) {
+		t.Run("putting a self-loop panics", func(t *testing.T) {
+			defer func() { _ = recover() }()
+			tt.emptyMutableGraph().PutEdge(node1, node1)
+			t.Errorf("MutableGraph.PutEdge: should have panicked")
+		})
+
+		t.Run(
+			"try putting a self-loop returns ErrSelfLoopNotAllowed",
+			func(t *testing.T) {
+				g := tt.emptyMutableGraph()
+
+				got, err := tryPutEdge(t, g, node1, node1)
+				if !errors.Is(err, graph.ErrSelfLoopNotAllowed) {
+					t.Fatalf(
+						"TryPutEdger.TryPutEdge: got error %v, want %v",
+						err,
+						graph.ErrSelfLoopNotAllowed,
+					)
+				}
+				if got {
+					t.Fatalf("TryPutEdger.TryPutEdge: got true, want false")
+				}
+			},
+		)
+
+		t.Run(
+			"try putting a self-loop leaves the graph unchanged",
+			func(t *testing.T) {
+				g := tt.emptyMutableGraph()
+				g.AddNode(node2)
+
+				_, _ = tryPutEdge(t, g, node1, node1)
+
+				testNodes(t, g, node2)
+				tt.testEdges(t, g)
+			},
+		)
 	})
 }
 
@@ -0,0 +1,303 @@
+package graph
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// Immutable returns a new ImmutableBuilder that builds ImmutableGraphs that
+// are directed and allow self-loops if and only if this builder does. The
+// built graphs keep this builder's NodeOrder, but not its IncidentEdgeOrder;
+// see ImmutableCopyOf.
+func (b Builder[N]) Immutable() *ImmutableBuilder[N] {
+	return &ImmutableBuilder[N]{
+		graph: b.Build(),
+	}
+}
+
+// ImmutableBuilder collects nodes and edges for building an ImmutableGraph.
+// Its methods return the builder itself so that calls can be chained, like:
+//
+//	g := graph.Directed[int]().Immutable().PutEdge(1, 2).PutEdge(2, 3).Build()
+type ImmutableBuilder[N comparable] struct {
+	graph *Graph[N]
+}
+
+// AddNode adds the given node to the graphs built by this builder.
+func (b *ImmutableBuilder[N]) AddNode(node N) *ImmutableBuilder[N] {
+	b.graph.AddNode(node)
+	return b
+}
+
+// PutEdge adds an edge from source to target, and the nodes themselves, to
+// the graphs built by this builder.
+//
+// PutEdge panics if source and target are the same node and this builder
+// disallows self-loops. Use TryPutEdge to get an error instead.
+func (b *ImmutableBuilder[N]) PutEdge(source N, target N) *ImmutableBuilder[N] {
+	if err := b.TryPutEdge(source, target); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// TryPutEdge is like PutEdge, but it returns an error instead of panicking,
+// so it cannot be chained. If source and target are the same node and this
+// builder disallows self-loops, it returns an *EdgeError wrapping
+// ErrSelfLoopNotAllowed and leaves this builder unchanged.
+func (b *ImmutableBuilder[N]) TryPutEdge(source N, target N) error {
+	_, err := b.graph.TryPutEdge(source, target)
+	return err
+}
+
+// Build returns a new ImmutableGraph with the nodes and edges added to this
+// builder so far. The builder can still be used afterwards; this does not
+// change any graphs that it has already built.
+func (b *ImmutableBuilder[N]) Build() *ImmutableGraph[N] {
+	return ImmutableCopyOf[N](b.graph)
+}
+
+// ImmutableCopyOf returns an ImmutableGraph with the same nodes and edges as
+// graph g. The returned graph is directed and allows self-loops if and only if
+// g does. If g is already an *ImmutableGraph, it is returned as is.
+//
+// The returned graph iterates over its nodes in the same order as g.Nodes, but
+// it ignores the order of g's neighbors, like the order set by
+// Builder.IncidentEdgeOrder: the neighbors of each node are iterated over in
+// the order of the nodes instead. Keeping them sorted this way is what lets
+// the returned graph find a neighbor with a binary search.
+func ImmutableCopyOf[N comparable](g GraphView[N]) *ImmutableGraph[N] {
+	if immutable, ok := g.(*ImmutableGraph[N]); ok {
+		return immutable
+	}
+
+	nodes := slices.Collect(g.Nodes().All())
+	if len(nodes) > math.MaxInt32 {
+		panic(fmt.Sprintf(
+			"graph has %d nodes, but an ImmutableGraph can have at most %d",
+			len(nodes),
+			math.MaxInt32,
+		))
+	}
+	nodeToIndex := make(map[N]int32, len(nodes))
+	for i, node := range nodes {
+		nodeToIndex[node] = int32(i)
+	}
+
+	successors := csrOf(nodes, nodeToIndex, g.Successors)
+	predecessors := successors
+	if g.IsDirected() {
+		predecessors = csrOf(nodes, nodeToIndex, g.Predecessors)
+	}
+
+	return &ImmutableGraph[N]{
+		directed:        g.IsDirected(),
+		allowsSelfLoops: g.AllowsSelfLoops(),
+		nodes:           nodes,
+		nodeToIndex:     nodeToIndex,
+		predecessors:    predecessors,
+		successors:      successors,
+		numEdges:        g.Edges().Len(),
+	}
+}
+
+// csr is an adjacency list in compressed sparse row form, where the
+// neighbors of the node with index i are the nodes with the sorted indices in
+// targets[offsets[i]:offsets[i+1]].
+type csr struct {
+	offsets []int
+	targets []int32
+}
+
+func csrOf[N comparable](
+	nodes []N,
+	nodeToIndex map[N]int32,
+	neighbors func(node N) SetView[N],
+) csr {
+	offsets := make([]int, 0, len(nodes)+1)
+	var targets []int32
+	for _, node := range nodes {
+		offsets = append(offsets, len(targets))
+		start := len(targets)
+		for neighbor := range neighbors(node).All() {
+			targets = append(targets, nodeToIndex[neighbor])
+		}
+		slices.Sort(targets[start:])
+	}
+	offsets = append(offsets, len(targets))
+
+	return csr{
+		offsets: offsets,
+		targets: slices.Clip(targets),
+	}
+}
+
+func (c csr) neighbors(index int32) []int32 {
+	start, end := c.offsets[index], c.offsets[index+1]
+	return c.targets[start:end:end]
+}
+
+// ImmutableGraph is a graph that cannot be changed after it is made, so it is
+// safe to share between goroutines without any further synchronization.
+//
+// Each node is given a dense int index, and the predecessors and successors of
+// all the nodes are stored in compressed sparse row (CSR) form: one flat slice
+// of neighbor indices, sorted per node, plus one slice of offsets into it per
+// node. This takes up much less memory than the maps used by Graph, and it is
+// faster to iterate over. Checking whether a node is a successor of another
+// node takes O(log(OutDegree)) time.
+//
+// An ImmutableGraph can be made with Builder.Immutable or ImmutableCopyOf.
+type ImmutableGraph[N comparable] struct {
+	nodes           []N
+	nodeToIndex     map[N]int32
+	predecessors    csr
+	successors      csr
+	numEdges        int
+	directed        bool
+	allowsSelfLoops bool
+}
+
+func (g *ImmutableGraph[N]) IsDirected() bool {
+	return g.directed
+}
+
+func (g *ImmutableGraph[N]) AllowsSelfLoops() bool {
+	return g.allowsSelfLoops
+}
+
+func (g *ImmutableGraph[N]) Nodes() SetView[N] {
+	return immutableNodeSet[N]{
+		graph: g,
+	}
+}
+
+func (g *ImmutableGraph[N]) Edges() SetView[EndpointPair[N]] {
+	return edgeSet[N]{
+		delegate: g,
+		len:      func() int { return g.numEdges },
+	}
+}
+
+func (g *ImmutableGraph[N]) AdjacentNodes(node N) SetView[N] {
+	return adjacentNodes[N](g, node)
+}
+
+func (g *ImmutableGraph[N]) Predecessors(node N) SetView[N] {
+	return g.neighbors(g.predecessors, node)
+}
+
+func (g *ImmutableGraph[N]) Successors(node N) SetView[N] {
+	return g.neighbors(g.successors, node)
+}
+
+func (g *ImmutableGraph[N]) neighbors(adjacency csr, node N) SetView[N] {
+	index, ok := g.nodeToIndex[node]
+	if !ok {
+		return immutableNeighborSet[N]{
+			graph: g,
+		}
+	}
+
+	return immutableNeighborSet[N]{
+		graph:   g,
+		indices: adjacency.neighbors(index),
+	}
+}
+
+func (g *ImmutableGraph[N]) IncidentEdges(node N) SetView[EndpointPair[N]] {
+	return incidentEdgeSet[N]{
+		node:     node,
+		delegate: g,
+	}
+}
+
+func (g *ImmutableGraph[N]) Degree(node N) int {
+	return degree[N](g, node)
+}
+
+func (g *ImmutableGraph[N]) InDegree(node N) int {
+	return inDegree[N](g, node)
+}
+
+func (g *ImmutableGraph[N]) OutDegree(node N) int {
+	return outDegree[N](g, node)
+}
+
+func (g *ImmutableGraph[N]) HasEdgeConnecting(source N, target N) bool {
+	return g.Successors(source).Contains(target)
+}
+
+func (g *ImmutableGraph[N]) HasEdgeConnectingEndpoints(
+	endpointPair EndpointPair[N],
+) bool {
+	return g.HasEdgeConnecting(endpointPair.Source(), endpointPair.Target())
+}
+
+func (g *ImmutableGraph[N]) String() string {
+	return stringOf[N](g)
+}
+
+type immutableNodeSet[N comparable] struct {
+	graph *ImmutableGraph[N]
+}
+
+func (i immutableNodeSet[N]) Contains(element N) bool {
+	_, ok := i.graph.nodeToIndex[element]
+	return ok
+}
+
+func (i immutableNodeSet[N]) Len() int {
+	return len(i.graph.nodes)
+}
+
+func (i immutableNodeSet[N]) All() iter.Seq[N] {
+	return func(yield func(N) bool) {
+		for _, node := range i.graph.nodes {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+func (i immutableNodeSet[N]) String() string {
+	return set.StringImpl[N](i)
+}
+
+type immutableNeighborSet[N comparable] struct {
+	graph   *ImmutableGraph[N]
+	indices []int32
+}
+
+func (i immutableNeighborSet[N]) Contains(element N) bool {
+	index, ok := i.graph.nodeToIndex[element]
+	if !ok {
+		return false
+	}
+
+	_, found := slices.BinarySearch(i.indices, index)
+	return found
+}
+
+func (i immutableNeighborSet[N]) Len() int {
+	return len(i.indices)
+}
+
+func (i immutableNeighborSet[N]) All() iter.Seq[N] {
+	return func(yield func(N) bool) {
+		for _, index := range i.indices {
+			if !yield(i.graph.nodes[index]) {
+				return
+			}
+		}
+	}
+}
+
+func (i immutableNeighborSet[N]) String() string {
+	return set.StringImpl[N](i)
+}
@@ -0,0 +1,404 @@
+package graph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/graph/graphtest"
+	internalsettest "github.com/jbduncan/go-containers/internal/settest"
+)
+
+func TestUndirectedImmutableGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestImmutable(
+		t,
+		func() graphtest.Graph[int] {
+			return graph.Undirected[int]().Immutable().Build()
+		},
+		addNodeToImmutableGraph,
+		putEdgeToImmutableGraph,
+		graphtest.Undirected,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestUndirectedAllowsSelfLoopsImmutableGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestImmutable(
+		t,
+		func() graphtest.Graph[int] {
+			return graph.Undirected[int]().
+				AllowsSelfLoops(true).
+				Immutable().
+				Build()
+		},
+		addNodeToImmutableGraph,
+		putEdgeToImmutableGraph,
+		graphtest.Undirected,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestDirectedImmutableGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestImmutable(
+		t,
+		func() graphtest.Graph[int] {
+			return graph.Directed[int]().Immutable().Build()
+		},
+		addNodeToImmutableGraph,
+		putEdgeToImmutableGraph,
+		graphtest.Directed,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestDirectedAllowsSelfLoopsImmutableGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestImmutable(
+		t,
+		func() graphtest.Graph[int] {
+			return graph.Directed[int]().
+				AllowsSelfLoops(true).
+				Immutable().
+				Build()
+		},
+		addNodeToImmutableGraph,
+		putEdgeToImmutableGraph,
+		graphtest.Directed,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestImmutableCopyOf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("has the same nodes, edges and properties", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().AllowsSelfLoops(true).Build()
+		g.PutEdge(1, 2)
+		g.PutEdge(2, 2)
+		g.AddNode(3)
+
+		if got := graph.ImmutableCopyOf[int](g); !graph.Equal[int](got, g) {
+			t.Errorf("graph.ImmutableCopyOf: got %v, want %v", got, g)
+		}
+	})
+
+	t.Run("does not reflect changes to the original graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2))
+		immutable := graph.ImmutableCopyOf[int](g)
+		nodes := immutable.Nodes()
+		g.PutEdge(2, 3)
+
+		want := undirectedIntGraphOf(edgeOf(1, 2))
+		if !graph.Equal[int](immutable, want) {
+			t.Errorf("graph.ImmutableCopyOf: got %v, want %v", immutable, want)
+		}
+		internalsettest.All(t, "ImmutableGraph.Nodes", nodes, []int{1, 2})
+	})
+
+	t.Run(
+		"keeps the node order but puts neighbors in node order",
+		func(t *testing.T) {
+			t.Parallel()
+
+			g := graph.Directed[int]().
+				NodeOrder(graph.Insertion[int]()).
+				IncidentEdgeOrder(graph.Insertion[int]()).
+				Build()
+			g.AddNode(3)
+			g.AddNode(1)
+			g.AddNode(2)
+			g.PutEdge(3, 2)
+			g.PutEdge(3, 1)
+
+			immutable := graph.ImmutableCopyOf[int](g)
+
+			nodes := slices.Collect(immutable.Nodes().All())
+			if want := []int{3, 1, 2}; !slices.Equal(nodes, want) {
+				t.Errorf("ImmutableGraph.Nodes: got %v, want %v", nodes, want)
+			}
+			successors := slices.Collect(immutable.Successors(3).All())
+			if want := []int{1, 2}; !slices.Equal(successors, want) {
+				t.Errorf(
+					"ImmutableGraph.Successors: got %v, want %v",
+					successors,
+					want,
+				)
+			}
+		},
+	)
+
+	t.Run("of an immutable graph returns the same graph", func(t *testing.T) {
+		t.Parallel()
+
+		immutable := graph.Directed[int]().Immutable().PutEdge(1, 2).Build()
+
+		if got := graph.ImmutableCopyOf[int](immutable); got != immutable {
+			t.Errorf(
+				"graph.ImmutableCopyOf: got a different graph, want the " +
+					"same graph",
+			)
+		}
+	})
+}
+
+func TestImmutableBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("builds the added nodes and edges", func(t *testing.T) {
+		t.Parallel()
+
+		got := graph.Directed[int]().
+			Immutable().
+			PutEdge(1, 2).
+			PutEdge(2, 3).
+			AddNode(4).
+			Build()
+
+		want := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3))
+		want.AddNode(4)
+		if !graph.Equal[int](got, want) {
+			t.Errorf("graph.ImmutableBuilder.Build: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("does not change already-built graphs", func(t *testing.T) {
+		t.Parallel()
+
+		builder := graph.Undirected[int]().Immutable().PutEdge(1, 2)
+		built := builder.Build()
+		builder.PutEdge(2, 3)
+
+		want := undirectedIntGraphOf(edgeOf(1, 2))
+		if !graph.Equal[int](built, want) {
+			t.Errorf("graph.ImmutableBuilder.Build: got %v, want %v", built, want)
+		}
+	})
+
+	t.Run("panics on a disallowed self-loop", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() { _ = recover() }()
+		graph.Directed[int]().Immutable().PutEdge(1, 1)
+		t.Errorf("graph.ImmutableBuilder.PutEdge: should have panicked")
+	})
+
+	t.Run("try putting an edge adds it", func(t *testing.T) {
+		t.Parallel()
+
+		builder := graph.Directed[int]().Immutable()
+
+		if err := builder.TryPutEdge(1, 2); err != nil {
+			t.Fatalf("graph.ImmutableBuilder.TryPutEdge: got error %v, want nil", err)
+		}
+
+		got := builder.Build()
+		want := directedIntGraphOf(edgeOf(1, 2))
+		if !graph.Equal[int](got, want) {
+			t.Errorf("graph.ImmutableBuilder.Build: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run(
+		"try putting a disallowed self-loop returns an EdgeError",
+		func(t *testing.T) {
+			t.Parallel()
+
+			builder := graph.Directed[int]().Immutable().PutEdge(1, 2)
+
+			err := builder.TryPutEdge(3, 3)
+
+			var edgeErr *graph.EdgeError[int]
+			if !errors.As(err, &edgeErr) ||
+				!errors.Is(err, graph.ErrSelfLoopNotAllowed) {
+				t.Fatalf(
+					"graph.ImmutableBuilder.TryPutEdge: got error %v, want "+
+						"*graph.EdgeError wrapping %v",
+					err,
+					graph.ErrSelfLoopNotAllowed,
+				)
+			}
+			got := builder.Build()
+			want := directedIntGraphOf(edgeOf(1, 2))
+			if !graph.Equal[int](got, want) {
+				t.Errorf("graph.ImmutableBuilder.Build: got %v, want %v", got, want)
+			}
+		},
+	)
+}
+
+func TestImmutableGraphIsSafeToShareBetweenGoroutines(t *testing.T) {
+	t.Parallel()
+
+	builder := graph.Directed[int]().Immutable()
+	for i := range 100 {
+		builder.PutEdge(i, i+1)
+	}
+	g := builder.Build()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				if !g.HasEdgeConnecting(i, i+1) {
+					t.Errorf("ImmutableGraph.HasEdgeConnecting: got false, want true")
+				}
+			}
+			if got := graph.Distances[int](g, 0)[100]; got != 100 {
+				t.Errorf("graph.Distances: got %d, want 100", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+const (
+	benchmarkNodes = 10_000
+	benchmarkEdges = 100_000
+)
+
+func BenchmarkGraphMemory(b *testing.B) {
+	b.Run("Graph", func(b *testing.B) {
+		var bytes uint64
+		for range b.N {
+			bytes = retainedBytes(b, func() any {
+				return randomDirectedGraph(benchmarkNodes, benchmarkEdges)
+			})
+		}
+		b.ReportMetric(float64(bytes)/benchmarkEdges, "B/edge")
+	})
+
+	b.Run("ImmutableGraph", func(b *testing.B) {
+		var bytes uint64
+		for range b.N {
+			bytes = retainedBytes(b, func() any {
+				return graph.ImmutableCopyOf[int](
+					randomDirectedGraph(benchmarkNodes, benchmarkEdges),
+				)
+			})
+		}
+		b.ReportMetric(float64(bytes)/benchmarkEdges, "B/edge")
+	})
+}
+
+func BenchmarkGraphTraversal(b *testing.B) {
+	g := randomDirectedGraph(benchmarkNodes, benchmarkEdges)
+	immutable := graph.ImmutableCopyOf[int](g)
+
+	b.Run("Graph", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			sumOfSuccessors(g)
+		}
+	})
+
+	b.Run("ImmutableGraph", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			sumOfSuccessors(immutable)
+		}
+	})
+}
+
+func BenchmarkGraphDistances(b *testing.B) {
+	g := randomDirectedGraph(benchmarkNodes, benchmarkEdges)
+	immutable := graph.ImmutableCopyOf[int](g)
+
+	b.Run("Graph", func(b *testing.B) {
+		for range b.N {
+			graph.Distances[int](g, 0)
+		}
+	})
+
+	b.Run("ImmutableGraph", func(b *testing.B) {
+		for range b.N {
+			graph.Distances[int](immutable, 0)
+		}
+	})
+}
+
+func randomDirectedGraph(nodes int, edges int) *graph.Graph[int] {
+	r := rand.New(rand.NewPCG(1, 2))
+	g := graph.Directed[int]().Build()
+	for g.Edges().Len() < edges {
+		source, target := r.IntN(nodes), r.IntN(nodes)
+		if source != target {
+			g.PutEdge(source, target)
+		}
+	}
+	return g
+}
+
+// retainedBytes returns the number of bytes on the heap that are still in use
+// by the value returned by newValue after garbage collection. If the heap
+// shrank meanwhile, because garbage collection freed more than the value uses,
+// the measurement is meaningless, so it is retried a few times before failing
+// the benchmark.
+func retainedBytes(b *testing.B, newValue func() any) uint64 {
+	b.Helper()
+
+	const attempts = 5
+	for range attempts {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		value := newValue()
+
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		runtime.KeepAlive(value)
+
+		if after.HeapAlloc >= before.HeapAlloc {
+			return after.HeapAlloc - before.HeapAlloc
+		}
+	}
+
+	b.Fatalf("retainedBytes: heap shrank in each of %d attempts", attempts)
+	return 0
+}
+
+func addNodeToImmutableGraph(
+	g graphtest.Graph[int],
+	node int,
+) graphtest.Graph[int] {
+	copied := graph.Copy(g)
+	copied.AddNode(node)
+	return graph.ImmutableCopyOf[int](copied)
+}
+
+func putEdgeToImmutableGraph(
+	g graphtest.Graph[int],
+	source int,
+	target int,
+) graphtest.Graph[int] {
+	copied := graph.Copy(g)
+	copied.PutEdge(source, target)
+	return graph.ImmutableCopyOf[int](copied)
+}
+
+func sumOfSuccessors(g graph.GraphView[int]) int {
+	result := 0
+	for node := range g.Nodes().All() {
+		for successor := range g.Successors(node).All() {
+			result += successor
+		}
+	}
+	return result
+}
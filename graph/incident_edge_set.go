@@ -8,7 +8,7 @@ import (
 
 type incidentEdgeSet[N comparable] struct {
 	node     N
-	delegate *Graph[N]
+	delegate GraphView[N]
 }
 
 func (i incidentEdgeSet[N]) Contains(element EndpointPair[N]) bool {
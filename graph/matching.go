@@ -0,0 +1,138 @@
+package graph
+
+import "github.com/jbduncan/go-containers/set"
+
+// HopcroftKarp returns a maximum matching of bipartite graph g, which is a set
+// of edges of g, no two of which share a node, that is as large as possible.
+// For example, if g connects workers to the jobs that they can do, a maximum
+// matching assigns as many jobs as possible, with at most one job per worker
+// and one worker per job. The direction of g's edges, if any, does not matter.
+//
+// Each pair in the returned set is an edge of g. Which node of a pair is its
+// source is undefined for undirected graphs, so EndpointPair.AdjacentNode is
+// the way to find a node's partner. If there is more than one maximum
+// matching, which one is returned is undefined.
+//
+// HopcroftKarp returns ErrNotBipartite if g is not bipartite (see
+// IsBipartite). It runs in O(E * sqrt(V)) time.
+//
+// The returned set is a read-only snapshot; it does not reflect later changes
+// to g.
+func HopcroftKarp[N comparable](
+	g GraphView[N],
+) (SetView[EndpointPair[N]], error) {
+	colouring, _, ok := IsBipartite(g)
+	if !ok {
+		return nil, ErrNotBipartite
+	}
+
+	m := &hopcroftKarp[N]{
+		g:           g,
+		leftToRight: make(map[N]N),
+		rightToLeft: make(map[N]N),
+		leftToLayer: make(map[N]int),
+		freeLayer:   -1,
+		leftNodes:   make([]N, 0, len(colouring)),
+	}
+	for node, colour := range colouring {
+		if colour == 0 {
+			m.leftNodes = append(m.leftNodes, node)
+		}
+	}
+	// Each round augments the matching along a maximal set of shortest
+	// augmenting paths that share no nodes, which takes O(sqrt(V)) rounds.
+	for m.layer() {
+		for _, left := range m.leftNodes {
+			if _, ok := m.leftToRight[left]; !ok {
+				m.augment(left)
+			}
+		}
+	}
+
+	result := set.WithCapacity[EndpointPair[N]](len(m.leftToRight))
+	for left, right := range m.leftToRight {
+		result.Add(edgeBetween(g, left, right))
+	}
+	return set.Unmodifiable[EndpointPair[N]](result), nil
+}
+
+type hopcroftKarp[N comparable] struct {
+	g GraphView[N]
+	// leftNodes has the nodes on one side of g; every edge goes between one
+	// of them and a node on the other side, the right side.
+	leftNodes   []N
+	leftToRight map[N]N
+	rightToLeft map[N]N
+	// leftToLayer has the layer of each left node that can be reached by an
+	// alternating path from a free left node, in the current round.
+	leftToLayer map[N]int
+	// freeLayer is the layer at which a free right node is first reached in
+	// the current round, or -1 if none is.
+	freeLayer int
+}
+
+// layer runs a breadth-first search along alternating paths from the free left
+// nodes to find the layer of each left node. Returns true if a free right node
+// can be reached, which means that there is an augmenting path.
+func (m *hopcroftKarp[N]) layer() bool {
+	clear(m.leftToLayer)
+	m.freeLayer = -1
+	var queue []N
+	for _, left := range m.leftNodes {
+		if _, ok := m.leftToRight[left]; !ok {
+			m.leftToLayer[left] = 0
+			queue = append(queue, left)
+		}
+	}
+
+	for len(queue) > 0 {
+		left := queue[0]
+		queue = queue[1:]
+		layer := m.leftToLayer[left]
+		if m.freeLayer != -1 && layer >= m.freeLayer {
+			// Only the shortest augmenting paths are needed.
+			continue
+		}
+
+		for right := range m.g.AdjacentNodes(left).All() {
+			next, ok := m.rightToLeft[right]
+			if !ok {
+				if m.freeLayer == -1 {
+					m.freeLayer = layer + 1
+				}
+				continue
+			}
+			if _, ok := m.leftToLayer[next]; !ok {
+				m.leftToLayer[next] = layer + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return m.freeLayer != -1
+}
+
+// augment looks for an augmenting path from left that goes down one layer at
+// a time, and flips the edges along it into and out of the matching. Returns
+// true if it finds one.
+func (m *hopcroftKarp[N]) augment(left N) bool {
+	layer := m.leftToLayer[left]
+	for right := range m.g.AdjacentNodes(left).All() {
+		next, ok := m.rightToLeft[right]
+		found := false
+		if !ok {
+			found = layer+1 == m.freeLayer
+		} else if nextLayer, ok := m.leftToLayer[next]; ok && nextLayer == layer+1 {
+			found = m.augment(next)
+		}
+		if found {
+			m.leftToRight[left] = right
+			m.rightToLeft[right] = left
+			return true
+		}
+	}
+
+	// No augmenting path goes through left in this round, so stop others
+	// from trying it again.
+	delete(m.leftToLayer, left)
+	return false
+}
@@ -0,0 +1,182 @@
+package graph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+func TestHopcroftKarp(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		g        *graph.Graph[int]
+		wantSize int
+	}
+	tests := []testCase{
+		{
+			name:     "empty graph",
+			g:        graph.Undirected[int]().Build(),
+			wantSize: 0,
+		},
+		{
+			name: "undirected: perfect matching that greedy matching misses",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 11),
+				edgeOf(1, 12),
+				edgeOf(2, 11),
+				edgeOf(3, 12),
+				edgeOf(3, 13),
+			),
+			wantSize: 3,
+		},
+		{
+			name: "undirected: more workers than jobs",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 11),
+				edgeOf(2, 11),
+				edgeOf(3, 11),
+				edgeOf(3, 12),
+			),
+			wantSize: 2,
+		},
+		{
+			name: "undirected: long augmenting path",
+			g: undirectedIntGraphOf(
+				edgeOf(1, 11),
+				edgeOf(2, 11),
+				edgeOf(2, 12),
+				edgeOf(3, 12),
+				edgeOf(3, 13),
+				edgeOf(4, 13),
+				edgeOf(4, 14),
+			),
+			wantSize: 4,
+		},
+		{
+			name: "directed: edges in both directions",
+			g: directedIntGraphOf(
+				edgeOf(1, 11),
+				edgeOf(12, 1),
+				edgeOf(12, 2),
+			),
+			wantSize: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			matching, err := graph.HopcroftKarp(tt.g)
+			if err != nil {
+				t.Fatalf("graph.HopcroftKarp: got error %v, want nil", err)
+			}
+
+			assertMatching(t, tt.g, matching)
+			if got := matching.Len(); got != tt.wantSize {
+				t.Errorf(
+					"graph.HopcroftKarp: got %v with %d pairs, want %d pairs",
+					matching,
+					got,
+					tt.wantSize,
+				)
+			}
+		})
+	}
+}
+
+func TestHopcroftKarpNotBipartite(t *testing.T) {
+	t.Parallel()
+
+	g := undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(3, 1))
+
+	matching, err := graph.HopcroftKarp(g)
+
+	if !errors.Is(err, graph.ErrNotBipartite) {
+		t.Errorf(
+			"graph.HopcroftKarp: got (%v, %v), want error %v",
+			matching,
+			err,
+			graph.ErrNotBipartite,
+		)
+	}
+}
+
+func TestHopcroftKarpOnRandomGraphs(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(5, 6))
+	for range 50 {
+		g := graph.Undirected[int]().Build()
+		for range 10 {
+			g.PutEdge(r.IntN(6), 6+r.IntN(6))
+		}
+
+		matching, err := graph.HopcroftKarp(g)
+		if err != nil {
+			t.Fatalf("graph.HopcroftKarp: got error %v, want nil", err)
+		}
+
+		assertMatching(t, g, matching)
+		edges := slices.Collect(g.Edges().All())
+		if got, want := matching.Len(), maximumMatchingSize(edges, set.Of[int]()); got != want {
+			t.Errorf(
+				"graph.HopcroftKarp: got %v with %d pairs for graph %v, want %d pairs",
+				matching,
+				got,
+				g,
+				want,
+			)
+		}
+	}
+}
+
+// assertMatching checks that every pair in matching is an edge of g and that
+// no two pairs share a node.
+func assertMatching(
+	t *testing.T,
+	g *graph.Graph[int],
+	matching graph.SetView[graph.EndpointPair[int]],
+) {
+	t.Helper()
+
+	matched := set.Of[int]()
+	for pair := range matching.All() {
+		if !g.HasEdgeConnectingEndpoints(pair) {
+			t.Errorf("graph.HopcroftKarp: got pair %v, which is not an edge", pair)
+		}
+		if !matched.Add(pair.Source()) || !matched.Add(pair.Target()) {
+			t.Errorf(
+				"graph.HopcroftKarp: got %v, in which more than one pair has a node of %v",
+				matching,
+				pair,
+			)
+		}
+	}
+}
+
+// maximumMatchingSize returns the size of a maximum matching of edges that
+// avoids the matched nodes, by trying every choice of edges.
+func maximumMatchingSize(
+	edges []graph.EndpointPair[int],
+	matched set.Set[int],
+) int {
+	if len(edges) == 0 {
+		return 0
+	}
+
+	edge, rest := edges[0], edges[1:]
+	result := maximumMatchingSize(rest, matched)
+	if !matched.Contains(edge.Source()) && !matched.Contains(edge.Target()) {
+		matched.Add(edge.Source(), edge.Target())
+		result = max(result, 1+maximumMatchingSize(rest, matched))
+		matched.Remove(edge.Source(), edge.Target())
+	}
+	return result
+}
@@ -8,7 +8,7 @@ import (
 
 type neighborSet[N comparable] struct {
 	node            N
-	nodeToNeighbors map[N]set.Set[N]
+	nodeToNeighbors map[N]mutableSet[N]
 }
 
 func (a neighborSet[N]) Contains(element N) bool {
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// ReachableNodes returns the set of nodes that are reachable from the given
+// node in graph g. A node b is reachable from a node a if there is a path from
+// a to b that follows the direction of g's edges if g is directed, or that
+// follows g.AdjacentNodes otherwise. A node is always reachable from itself
+// via a path with no edges.
+//
+// The returned set is a read-only snapshot; it does not reflect later changes
+// to g.
+//
+// ReachableNodes panics if node is not in g.
+func ReachableNodes[N comparable](
+	g GraphView[N],
+	node N,
+) SetView[N] {
+	if !g.Nodes().Contains(node) {
+		panic(fmt.Sprintf("node %v is not an element of this graph", node))
+	}
+
+	return set.Unmodifiable[N](reachableNodes(g, node))
+}
+
+func reachableNodes[N comparable](
+	g GraphView[N],
+	node N,
+) set.Set[N] {
+	visited := set.Of(node)
+	queue := []N{node}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for next := range outgoingNodes(g, current).All() {
+			if visited.Add(next) {
+				queue = append(queue, next)
+			}
+		}
+	}
+	return visited
+}
+
+// TransitiveClosure returns the transitive closure of graph g as a new graph.
+// The transitive closure has the same nodes as g and an edge from node a to
+// node b for every node b that is reachable from node a in g (see
+// ReachableNodes). The returned graph is directed if and only if g is
+// directed, and it allows self-loops if and only if g does.
+//
+// If g allows self-loops, this is a "reflexive" transitive closure: every node
+// in the returned graph has a self-loop, since every node is reachable from
+// itself. If g disallows self-loops, then the returned graph has no
+// self-loops. This differs from Guava's Graphs.transitiveClosure, which always
+// returns a graph that allows self-loops and gives every node a self-loop.
+//
+// The returned graph is a copy; it does not reflect later changes to g.
+func TransitiveClosure[N comparable](
+	g GraphView[N],
+) *Graph[N] {
+	result := builderLike[N](g).Build()
+
+	putEdge := func(source N, target N) {
+		if source == target && !g.AllowsSelfLoops() {
+			return
+		}
+		result.PutEdge(source, target)
+	}
+
+	if g.IsDirected() {
+		for node := range g.Nodes().All() {
+			result.AddNode(node)
+			for reachable := range reachableNodes(g, node).All() {
+				putEdge(node, reachable)
+			}
+		}
+		return result
+	}
+
+	// In an undirected graph, every node in a connected component is reachable
+	// from every other node in that component, so each component only needs
+	// to be traversed once.
+	visited := set.Of[N]()
+	for node := range g.Nodes().All() {
+		if visited.Contains(node) {
+			continue
+		}
+
+		component := slices.Collect(reachableNodes(g, node).All())
+		for i, source := range component {
+			visited.Add(source)
+			result.AddNode(source)
+			for _, target := range component[i:] {
+				putEdge(source, target)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	internalsettest "github.com/jbduncan/go-containers/internal/settest"
+)
+
+func TestReachableNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directed graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(4, 1))
+
+		testReachableNodes(t, graph.ReachableNodes(g, 1), 1, 2, 3)
+		testReachableNodes(t, graph.ReachableNodes(g, 3), 3)
+	})
+
+	t.Run("undirected graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2), edgeOf(3, 2), edgeOf(4, 5))
+
+		testReachableNodes(t, graph.ReachableNodes(g, 1), 1, 2, 3)
+		testReachableNodes(t, graph.ReachableNodes(g, 5), 4, 5)
+	})
+
+	t.Run("directed graph with a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(3, 1))
+
+		testReachableNodes(t, graph.ReachableNodes(g, 2), 1, 2, 3)
+	})
+
+	t.Run("is a snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+		reachable := graph.ReachableNodes(g, 1)
+		g.PutEdge(2, 3)
+
+		testReachableNodes(t, reachable, 1, 2)
+	})
+
+	t.Run("panics on an absent node", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() { _ = recover() }()
+		graph.ReachableNodes(directedIntGraphOf(), nodeNotInGraph)
+		t.Errorf("graph.ReachableNodes: should have panicked")
+	})
+}
+
+func testReachableNodes(
+	t *testing.T,
+	reachable graph.SetView[int],
+	expectedNodes ...int,
+) {
+	t.Helper()
+
+	internalsettest.Len(t, "graph.ReachableNodes", reachable, len(expectedNodes))
+	internalsettest.All(t, "graph.ReachableNodes", reachable, expectedNodes)
+	internalsettest.IsMutable(t, "graph.ReachableNodes", reachable)
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+		g    *graph.Graph[int]
+		want *graph.Graph[int]
+	}
+	tests := []testCase{
+		{
+			name: "directed path",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(1, 3)),
+		},
+		{
+			name: "directed cycle",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 1)),
+			want: directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 1)),
+		},
+		{
+			name: "undirected path",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: undirectedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(1, 3),
+			),
+		},
+		{
+			name: "undirected graph with two components",
+			g:    undirectedIntGraphOf(edgeOf(1, 2), edgeOf(3, 4)),
+			want: undirectedIntGraphOf(edgeOf(1, 2), edgeOf(3, 4)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := graph.TransitiveClosure(tt.g); !graph.Equal[int](got, tt.want) {
+				t.Errorf(
+					"graph.TransitiveClosure: got %v, want %v",
+					got,
+					tt.want,
+				)
+			}
+		})
+	}
+
+	t.Run("reflexive when self-loops are allowed", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().AllowsSelfLoops(true).Build()
+		g.PutEdge(1, 2)
+		g.AddNode(3)
+
+		want := graph.Directed[int]().AllowsSelfLoops(true).Build()
+		want.PutEdge(1, 1)
+		want.PutEdge(1, 2)
+		want.PutEdge(2, 2)
+		want.PutEdge(3, 3)
+
+		if got := graph.TransitiveClosure(g); !graph.Equal[int](got, want) {
+			t.Errorf("graph.TransitiveClosure: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("keeps isolated nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().Build()
+		g.AddNode(1)
+
+		want := graph.Undirected[int]().Build()
+		want.AddNode(1)
+
+		if got := graph.TransitiveClosure(g); !graph.Equal[int](got, want) {
+			t.Errorf("graph.TransitiveClosure: got %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package graph
+
+import "slices"
+
+// ShortestPath returns a path with the fewest edges from node from to node to
+// in graph g, as a slice of nodes that starts with from and ends with to. If to
+// is not reachable from from, or if either node is not in g, it returns nil
+// and false.
+//
+// If g is directed, the path follows the direction of g's edges, otherwise it
+// follows g.AdjacentNodes. If there is more than one shortest path, which one
+// is returned is undefined.
+//
+// ShortestPath runs a breadth-first search from from, so it runs in O(V + E)
+// time in the worst case.
+func ShortestPath[N comparable](
+	g GraphView[N],
+	from N,
+	to N,
+) ([]N, bool) {
+	if !g.Nodes().Contains(from) || !g.Nodes().Contains(to) {
+		return nil, false
+	}
+
+	nodeToParent := map[N]N{from: from}
+	queue := []N{from}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == to {
+			return pathTo(nodeToParent, to), true
+		}
+
+		for next := range outgoingNodes(g, node).All() {
+			if _, ok := nodeToParent[next]; ok {
+				continue
+			}
+			nodeToParent[next] = node
+			queue = append(queue, next)
+		}
+	}
+
+	return nil, false
+}
+
+// Distances returns the number of edges on a shortest path from node from to
+// every node that is reachable from it in graph g, including from itself with
+// a distance of 0. If from is not in g, it returns an empty map.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes.
+func Distances[N comparable](
+	g GraphView[N],
+	from N,
+) map[N]int {
+	result := make(map[N]int)
+	if !g.Nodes().Contains(from) {
+		return result
+	}
+
+	result[from] = 0
+	queue := []N{from}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for next := range outgoingNodes(g, node).All() {
+			if _, ok := result[next]; ok {
+				continue
+			}
+			result[next] = result[node] + 1
+			queue = append(queue, next)
+		}
+	}
+
+	return result
+}
+
+// AllDistances returns the number of edges on a shortest path between every
+// pair of nodes in graph g where the second node is reachable from the first.
+// The distance from node a to node b is AllDistances(g)[a][b].
+//
+// AllDistances runs Distances once per node, so it runs in O(V * (V + E))
+// time.
+func AllDistances[N comparable](
+	g GraphView[N],
+) map[N]map[N]int {
+	result := make(map[N]map[N]int, g.Nodes().Len())
+	for node := range g.Nodes().All() {
+		result[node] = Distances(g, node)
+	}
+	return result
+}
+
+// ShortestPathBidirectional returns the same kind of path as ShortestPath, but
+// it searches forwards from node from and backwards from node to at the same
+// time, always expanding the smaller of the two frontiers. On large graphs
+// this usually visits far fewer nodes than ShortestPath.
+//
+// If g is directed, the backwards search follows g.Predecessors.
+func ShortestPathBidirectional[N comparable](
+	g GraphView[N],
+	from N,
+	to N,
+) ([]N, bool) {
+	if !g.Nodes().Contains(from) || !g.Nodes().Contains(to) {
+		return nil, false
+	}
+	if from == to {
+		return []N{from}, true
+	}
+
+	forwardParents := map[N]N{from: from}
+	backwardParents := map[N]N{to: to}
+	forwardFrontier := []N{from}
+	backwardFrontier := []N{to}
+
+	for len(forwardFrontier) > 0 && len(backwardFrontier) > 0 {
+		var meeting N
+		var met bool
+		if len(forwardFrontier) <= len(backwardFrontier) {
+			forwardFrontier, meeting, met = expandFrontier(
+				forwardFrontier,
+				forwardParents,
+				backwardParents,
+				func(node N) SetView[N] { return outgoingNodes(g, node) },
+			)
+		} else {
+			backwardFrontier, meeting, met = expandFrontier(
+				backwardFrontier,
+				backwardParents,
+				forwardParents,
+				func(node N) SetView[N] { return incomingNodes(g, node) },
+			)
+		}
+
+		if met {
+			path := pathTo(forwardParents, meeting)
+			for node := meeting; node != to; {
+				node = backwardParents[node]
+				path = append(path, node)
+			}
+			return path, true
+		}
+	}
+
+	return nil, false
+}
+
+// expandFrontier visits every neighbor of every node in frontier that has not
+// been visited yet, recording their parents in parents. It returns the next
+// frontier, or the first visited node that has already been visited by the
+// search in the other direction.
+func expandFrontier[N comparable](
+	frontier []N,
+	parents map[N]N,
+	otherParents map[N]N,
+	neighbors func(node N) SetView[N],
+) (nextFrontier []N, meeting N, met bool) {
+	for _, node := range frontier {
+		for next := range neighbors(node).All() {
+			if _, ok := parents[next]; ok {
+				continue
+			}
+			parents[next] = node
+			if _, ok := otherParents[next]; ok {
+				return nil, next, true
+			}
+			nextFrontier = append(nextFrontier, next)
+		}
+	}
+	return nextFrontier, meeting, false
+}
+
+func outgoingNodes[N comparable](
+	g GraphView[N],
+	node N,
+) SetView[N] {
+	if g.IsDirected() {
+		return g.Successors(node)
+	}
+	return g.AdjacentNodes(node)
+}
+
+func incomingNodes[N comparable](
+	g GraphView[N],
+	node N,
+) SetView[N] {
+	if g.IsDirected() {
+		return g.Predecessors(node)
+	}
+	return g.AdjacentNodes(node)
+}
+
+// pathTo follows nodeToParent from node back to the root, the only node that
+// is its own parent, and returns the nodes visited in root-to-node order.
+func pathTo[N comparable](nodeToParent map[N]N, node N) []N {
+	path := []N{node}
+	for {
+		parent := nodeToParent[node]
+		if parent == node {
+			break
+		}
+		path = append(path, parent)
+		node = parent
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,239 @@
+package graph_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+func TestShortestPath(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		g        *graph.Graph[int]
+		from, to int
+		wantPath []int
+		wantOk   bool
+	}
+	tests := []testCase{
+		{
+			name:     "directed: same node",
+			g:        directedIntGraphOf(edgeOf(1, 2)),
+			from:     1,
+			to:       1,
+			wantPath: []int{1},
+			wantOk:   true,
+		},
+		{
+			name:     "directed: one edge",
+			g:        directedIntGraphOf(edgeOf(1, 2)),
+			from:     1,
+			to:       2,
+			wantPath: []int{1, 2},
+			wantOk:   true,
+		},
+		{
+			name:   "directed: against the direction of an edge",
+			g:      directedIntGraphOf(edgeOf(1, 2)),
+			from:   2,
+			to:     1,
+			wantOk: false,
+		},
+		{
+			name: "directed: shortcut is preferred",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 4),
+				edgeOf(1, 5),
+				edgeOf(5, 4),
+			),
+			from:     1,
+			to:       4,
+			wantPath: []int{1, 5, 4},
+			wantOk:   true,
+		},
+		{
+			name:     "undirected: against the order an edge was put in",
+			g:        undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			from:     3,
+			to:       1,
+			wantPath: []int{3, 2, 1},
+			wantOk:   true,
+		},
+		{
+			name:   "undirected: disconnected nodes",
+			g:      undirectedIntGraphOf(edgeOf(1, 2), edgeOf(3, 4)),
+			from:   1,
+			to:     4,
+			wantOk: false,
+		},
+		{
+			name:   "absent source node",
+			g:      directedIntGraphOf(edgeOf(1, 2)),
+			from:   nodeNotInGraph,
+			to:     2,
+			wantOk: false,
+		},
+		{
+			name:   "absent target node",
+			g:      directedIntGraphOf(edgeOf(1, 2)),
+			from:   1,
+			to:     nodeNotInGraph,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, ok := graph.ShortestPath(tt.g, tt.from, tt.to)
+			if ok != tt.wantOk || !slices.Equal(path, tt.wantPath) {
+				t.Errorf(
+					"graph.ShortestPath: got (%v, %t), want (%v, %t)",
+					path,
+					ok,
+					tt.wantPath,
+					tt.wantOk,
+				)
+			}
+
+			path, ok = graph.ShortestPathBidirectional(tt.g, tt.from, tt.to)
+			if ok != tt.wantOk || !slices.Equal(path, tt.wantPath) {
+				t.Errorf(
+					"graph.ShortestPathBidirectional: got (%v, %t), want (%v, %t)",
+					path,
+					ok,
+					tt.wantPath,
+					tt.wantOk,
+				)
+			}
+		})
+	}
+}
+
+func TestShortestPathBidirectionalOnGrid(t *testing.T) {
+	t.Parallel()
+
+	// A 10x10 undirected grid, where node r*10+c is at row r and column c.
+	g := graph.Undirected[int]().Build()
+	for r := range 10 {
+		for c := range 10 {
+			if c < 9 {
+				g.PutEdge(r*10+c, r*10+c+1)
+			}
+			if r < 9 {
+				g.PutEdge(r*10+c, (r+1)*10+c)
+			}
+		}
+	}
+
+	for _, target := range []int{1, 11, 45, 90, 99} {
+		want, _ := graph.ShortestPath(g, 0, target)
+		got, ok := graph.ShortestPathBidirectional(g, 0, target)
+		if !ok || len(got) != len(want) {
+			t.Fatalf(
+				"graph.ShortestPathBidirectional(g, 0, %d): got (%v, %t), "+
+					"want a path with %d nodes",
+				target,
+				got,
+				ok,
+				len(want),
+			)
+		}
+		for i := 1; i < len(got); i++ {
+			if !g.HasEdgeConnecting(got[i-1], got[i]) {
+				t.Fatalf(
+					"graph.ShortestPathBidirectional(g, 0, %d): got %v, "+
+						"which is not a path in the graph",
+					target,
+					got,
+				)
+			}
+		}
+	}
+}
+
+func TestDistances(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directed graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(
+			edgeOf(1, 2),
+			edgeOf(2, 3),
+			edgeOf(1, 3),
+			edgeOf(4, 1),
+		)
+
+		got := graph.Distances(g, 1)
+		want := map[int]int{1: 0, 2: 1, 3: 1}
+		if !maps.Equal(got, want) {
+			t.Errorf("graph.Distances: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("undirected graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(4, 5))
+
+		got := graph.Distances(g, 3)
+		want := map[int]int{1: 2, 2: 1, 3: 0}
+		if !maps.Equal(got, want) {
+			t.Errorf("graph.Distances: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("absent node", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2))
+
+		if got := graph.Distances(g, nodeNotInGraph); len(got) != 0 {
+			t.Errorf("graph.Distances: got %v, want an empty map", got)
+		}
+	})
+}
+
+func TestAllDistances(t *testing.T) {
+	t.Parallel()
+
+	g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3))
+
+	got := graph.AllDistances(g)
+	want := map[int]map[int]int{
+		1: {1: 0, 2: 1, 3: 2},
+		2: {2: 0, 3: 1},
+		3: {3: 0},
+	}
+	if !maps.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("graph.AllDistances: got %v, want %v", got, want)
+	}
+}
+
+const nodeNotInGraph = 1_000
+
+func directedIntGraphOf(edges ...graph.EndpointPair[int]) *graph.Graph[int] {
+	result := graph.Directed[int]().Build()
+	for _, e := range edges {
+		result.PutEdge(e.Source(), e.Target())
+	}
+	return result
+}
+
+func undirectedIntGraphOf(edges ...graph.EndpointPair[int]) *graph.Graph[int] {
+	result := graph.Undirected[int]().Build()
+	for _, e := range edges {
+		result.PutEdge(e.Source(), e.Target())
+	}
+	return result
+}
+
+func edgeOf(source int, target int) graph.EndpointPair[int] {
+	return graph.EndpointPairOf(source, target)
+}
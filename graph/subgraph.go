@@ -0,0 +1,208 @@
+package graph
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// InducedSubgraph returns the subgraph of graph g induced by the given nodes,
+// as a new graph. The induced subgraph has just the given nodes and every edge
+// in g that connects two of those nodes. It is directed and allows self-loops
+// if and only if g does.
+//
+// The returned graph is a copy; it does not reflect later changes to g. For a
+// live view, use Filter.
+//
+// InducedSubgraph panics if any of the given nodes is not in g.
+func InducedSubgraph[N comparable](
+	g GraphView[N],
+	nodes iter.Seq[N],
+) *Graph[N] {
+	result := builderLike[N](g).Build()
+	for node := range nodes {
+		if !g.Nodes().Contains(node) {
+			panic(fmt.Sprintf("node %v is not an element of this graph", node))
+		}
+		result.AddNode(node)
+	}
+
+	for source := range result.Nodes().All() {
+		for target := range g.Successors(source).All() {
+			if result.Nodes().Contains(target) {
+				result.PutEdge(source, target)
+			}
+		}
+	}
+	return result
+}
+
+// Filter returns a read-only view of graph g that has just the nodes that
+// satisfy nodePredicate and just the edges that satisfy edgePredicate and that
+// connect two such nodes. A nil predicate is satisfied by every node or edge.
+//
+// If g is undirected, edgePredicate must return the same result for an edge
+// and its reverse, because the view may pass either one to it.
+//
+// No nodes or edges are copied, so changes to g are reflected in the view.
+// However, this means that the Len method of most of the set views returned by
+// the view's methods runs in linear time, not constant time. For a copy, use
+// InducedSubgraph.
+func Filter[N comparable](
+	g GraphView[N],
+	nodePredicate func(node N) bool,
+	edgePredicate func(edge EndpointPair[N]) bool,
+) FilteredGraph[N] {
+	if nodePredicate == nil {
+		nodePredicate = func(N) bool { return true }
+	}
+	if edgePredicate == nil {
+		edgePredicate = func(EndpointPair[N]) bool { return true }
+	}
+
+	return FilteredGraph[N]{
+		delegate:      g,
+		nodePredicate: nodePredicate,
+		edgePredicate: edgePredicate,
+	}
+}
+
+type FilteredGraph[N comparable] struct {
+	delegate      GraphView[N]
+	nodePredicate func(node N) bool
+	edgePredicate func(edge EndpointPair[N]) bool
+}
+
+func (f FilteredGraph[N]) IsDirected() bool {
+	return f.delegate.IsDirected()
+}
+
+func (f FilteredGraph[N]) AllowsSelfLoops() bool {
+	return f.delegate.AllowsSelfLoops()
+}
+
+func (f FilteredGraph[N]) Nodes() SetView[N] {
+	return filteredSet[N]{
+		delegate:  f.delegate.Nodes(),
+		predicate: f.nodePredicate,
+	}
+}
+
+func (f FilteredGraph[N]) Edges() SetView[EndpointPair[N]] {
+	return edgeSet[N]{
+		delegate: f,
+		len: func() int {
+			result := 0
+			for node := range f.Nodes().All() {
+				if f.IsDirected() {
+					result += f.OutDegree(node)
+				} else {
+					result += f.Degree(node)
+				}
+			}
+			if !f.IsDirected() {
+				// Every undirected edge has been counted once from each end.
+				result /= 2
+			}
+			return result
+		},
+	}
+}
+
+func (f FilteredGraph[N]) AdjacentNodes(node N) SetView[N] {
+	return adjacentNodes[N](f, node)
+}
+
+func (f FilteredGraph[N]) Predecessors(node N) SetView[N] {
+	return filteredSet[N]{
+		delegate: f.delegate.Predecessors(node),
+		predicate: func(predecessor N) bool {
+			return f.hasNode(node) &&
+				f.nodePredicate(predecessor) &&
+				f.edgePredicate(EndpointPairOf(predecessor, node))
+		},
+	}
+}
+
+func (f FilteredGraph[N]) Successors(node N) SetView[N] {
+	return filteredSet[N]{
+		delegate: f.delegate.Successors(node),
+		predicate: func(successor N) bool {
+			return f.hasNode(node) &&
+				f.nodePredicate(successor) &&
+				f.edgePredicate(EndpointPairOf(node, successor))
+		},
+	}
+}
+
+func (f FilteredGraph[N]) IncidentEdges(node N) SetView[EndpointPair[N]] {
+	return incidentEdgeSet[N]{
+		node:     node,
+		delegate: f,
+	}
+}
+
+func (f FilteredGraph[N]) Degree(node N) int {
+	return degree[N](f, node)
+}
+
+func (f FilteredGraph[N]) InDegree(node N) int {
+	return inDegree[N](f, node)
+}
+
+func (f FilteredGraph[N]) OutDegree(node N) int {
+	return outDegree[N](f, node)
+}
+
+func (f FilteredGraph[N]) HasEdgeConnecting(source N, target N) bool {
+	return f.Successors(source).Contains(target)
+}
+
+func (f FilteredGraph[N]) HasEdgeConnectingEndpoints(
+	endpointPair EndpointPair[N],
+) bool {
+	return f.HasEdgeConnecting(endpointPair.Source(), endpointPair.Target())
+}
+
+func (f FilteredGraph[N]) String() string {
+	return stringOf[N](f)
+}
+
+func (f FilteredGraph[N]) hasNode(node N) bool {
+	return f.delegate.Nodes().Contains(node) && f.nodePredicate(node)
+}
+
+type filteredSet[T comparable] struct {
+	delegate  SetView[T]
+	predicate func(element T) bool
+}
+
+func (f filteredSet[T]) Contains(element T) bool {
+	return f.delegate.Contains(element) && f.predicate(element)
+}
+
+func (f filteredSet[T]) Len() int {
+	result := 0
+	for range f.All() {
+		result++
+	}
+	return result
+}
+
+func (f filteredSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range f.delegate.All() {
+			if !f.predicate(element) {
+				continue
+			}
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+func (f filteredSet[T]) String() string {
+	return set.StringImpl[T](f)
+}
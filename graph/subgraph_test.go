@@ -0,0 +1,234 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/graph/graphtest"
+)
+
+func TestUndirectedFilteredGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return emptyFilteredGraph(graph.Undirected[int]())
+		},
+		addNodeToFilteredGraph,
+		putEdgeToFilteredGraph,
+		graphtest.Undirected,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestUndirectedAllowsSelfLoopsFilteredGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return emptyFilteredGraph(graph.Undirected[int]().AllowsSelfLoops(true))
+		},
+		addNodeToFilteredGraph,
+		putEdgeToFilteredGraph,
+		graphtest.Undirected,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestDirectedFilteredGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return emptyFilteredGraph(graph.Directed[int]())
+		},
+		addNodeToFilteredGraph,
+		putEdgeToFilteredGraph,
+		graphtest.Directed,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestDirectedAllowsSelfLoopsFilteredGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return emptyFilteredGraph(graph.Directed[int]().AllowsSelfLoops(true))
+		},
+		addNodeToFilteredGraph,
+		putEdgeToFilteredGraph,
+		graphtest.Directed,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hides nodes and their edges", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(1, 3))
+		filtered := graph.Filter(g, func(node int) bool { return node != 2 }, nil)
+
+		want := directedIntGraphOf(edgeOf(1, 3))
+		if !graph.Equal[int](filtered, want) {
+			t.Errorf("graph.Filter: got %v, want %v", filtered, want)
+		}
+	})
+
+	t.Run("hides edges but keeps their nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3))
+		filtered := graph.Filter(
+			g,
+			nil,
+			func(edge graph.EndpointPair[int]) bool {
+				return edge != edgeOf(1, 2) && edge != edgeOf(2, 1)
+			},
+		)
+
+		want := undirectedIntGraphOf(edgeOf(2, 3))
+		want.AddNode(1)
+		if !graph.Equal[int](filtered, want) {
+			t.Errorf("graph.Filter: got %v, want %v", filtered, want)
+		}
+		if got := filtered.Degree(2); got != 1 {
+			t.Errorf("graph.Filter: got Graph.Degree of %d, want 1", got)
+		}
+	})
+
+	t.Run("reflects changes to the original graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+		filtered := graph.Filter(g, func(node int) bool { return node < 10 }, nil)
+		g.PutEdge(2, 3)
+		g.PutEdge(3, 10)
+
+		want := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3))
+		if !graph.Equal[int](filtered, want) {
+			t.Errorf("graph.Filter: got %v, want %v", filtered, want)
+		}
+	})
+}
+
+func TestInducedSubgraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps edges between the given nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(
+			edgeOf(1, 2),
+			edgeOf(2, 3),
+			edgeOf(3, 1),
+			edgeOf(3, 4),
+		)
+
+		got := graph.InducedSubgraph(g, slices.Values([]int{1, 2, 3}))
+
+		want := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(3, 1))
+		if !graph.Equal[int](got, want) {
+			t.Errorf("graph.InducedSubgraph: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("keeps self-loops and isolated nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Undirected[int]().AllowsSelfLoops(true).Build()
+		g.PutEdge(1, 1)
+		g.PutEdge(1, 2)
+		g.AddNode(3)
+
+		got := graph.InducedSubgraph(g, slices.Values([]int{1, 3}))
+
+		want := graph.Undirected[int]().AllowsSelfLoops(true).Build()
+		want.PutEdge(1, 1)
+		want.AddNode(3)
+		if !graph.Equal[int](got, want) {
+			t.Errorf("graph.InducedSubgraph: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("is a copy", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+		got := graph.InducedSubgraph(g, g.Nodes().All())
+		g.PutEdge(2, 1)
+
+		want := directedIntGraphOf(edgeOf(1, 2))
+		if !graph.Equal[int](got, want) {
+			t.Errorf("graph.InducedSubgraph: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("panics on an absent node", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() { _ = recover() }()
+		graph.InducedSubgraph(
+			directedIntGraphOf(edgeOf(1, 2)),
+			slices.Values([]int{1, nodeNotInGraph}),
+		)
+		t.Errorf("graph.InducedSubgraph: should have panicked")
+	})
+}
+
+// filteredGraph pairs a filtered view with the graph it is a view of, so that
+// graphtest.TestReadOnly can add nodes and edges to the view by adding them
+// to the original graph.
+type filteredGraph struct {
+	graph.FilteredGraph[int]
+
+	original *graph.Graph[int]
+}
+
+func newFilteredGraph(original *graph.Graph[int]) filteredGraph {
+	return filteredGraph{
+		FilteredGraph: graph.Filter(
+			original,
+			func(node int) bool { return node >= 0 },
+			func(edge graph.EndpointPair[int]) bool {
+				return edge.Source() >= 0 && edge.Target() >= 0
+			},
+		),
+		original: original,
+	}
+}
+
+// emptyFilteredGraph returns a filtered view with no nodes or edges of a graph
+// built by builder. The original graph has nodes and edges that the view
+// hides, to check that they stay hidden.
+func emptyFilteredGraph(builder graph.Builder[int]) filteredGraph {
+	original := builder.Build()
+	original.PutEdge(-1, -2)
+	original.PutEdge(-2, -3)
+	return newFilteredGraph(original)
+}
+
+func addNodeToFilteredGraph(
+	g graphtest.Graph[int],
+	node int,
+) graphtest.Graph[int] {
+	g.(filteredGraph).original.AddNode(node)
+	return g
+}
+
+func putEdgeToFilteredGraph(
+	g graphtest.Graph[int],
+	source int,
+	target int,
+) graphtest.Graph[int] {
+	g.(filteredGraph).original.PutEdge(source, target)
+	return g
+}
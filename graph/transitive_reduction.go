@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// TransitiveReduction returns the transitive reduction of directed acyclic
+// graph g as a new graph. The transitive reduction has the same nodes as g and
+// the fewest edges possible such that a node b is reachable from a node a in
+// the reduction if and only if it is reachable in g. In other words, it is g
+// without any edge a -> b where b can also be reached from a by a longer path.
+//
+// The returned graph is directed, and it allows self-loops if and only if g
+// does, so for any directed acyclic graph g, the following is true:
+//
+//	graph.Equal(TransitiveClosure(reduction), TransitiveClosure(g))
+//
+// TransitiveReduction returns ErrUndirected if g is undirected, or ErrCycle if
+// g has a cycle, including a self-loop.
+//
+// The returned graph is a copy; it does not reflect later changes to g.
+func TransitiveReduction[N comparable](
+	g GraphView[N],
+) (*Graph[N], error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirected
+	}
+
+	order, ok := topologicalOrder(g)
+	if !ok {
+		return nil, ErrCycle
+	}
+	nodeToIndex := make(map[N]int, len(order))
+	for i, node := range order {
+		nodeToIndex[node] = i
+	}
+
+	result := Directed[N]().AllowsSelfLoops(g.AllowsSelfLoops()).Build()
+
+	// Visit the nodes in reverse topological order, so that the nodes
+	// reachable from each successor of a node are already known by the time
+	// that node is visited.
+	nodeToReachable := make(map[N]set.Set[N], len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		node := order[i]
+		result.AddNode(node)
+
+		// An edge node -> successor is redundant if and only if successor is
+		// reachable from another successor of node. Any such other successor
+		// comes earlier in topological order, so visiting the successors in
+		// topological order means that it is seen first.
+		successors := slices.Collect(g.Successors(node).All())
+		slices.SortFunc(successors, func(a, b N) int {
+			return cmp.Compare(nodeToIndex[a], nodeToIndex[b])
+		})
+
+		reachable := set.Of(node)
+		for _, successor := range successors {
+			if reachable.Contains(successor) {
+				continue
+			}
+
+			result.PutEdge(node, successor)
+			for n := range nodeToReachable[successor].All() {
+				reachable.Add(n)
+			}
+		}
+		nodeToReachable[node] = reachable
+	}
+
+	return result, nil
+}
+
+// topologicalOrder returns the nodes of directed graph g in an order where
+// every node comes before all of its successors, using Kahn's algorithm. If g
+// has a cycle, then no such order exists, so it returns false.
+func topologicalOrder[N comparable](
+	g GraphView[N],
+) ([]N, bool) {
+	nodeToInDegree := make(map[N]int, g.Nodes().Len())
+	var queue []N
+	for node := range g.Nodes().All() {
+		inDegree := g.Predecessors(node).Len()
+		nodeToInDegree[node] = inDegree
+		if inDegree == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	order := make([]N, 0, g.Nodes().Len())
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		order = append(order, node)
+
+		for successor := range g.Successors(node).All() {
+			nodeToInDegree[successor]--
+			if nodeToInDegree[successor] == 0 {
+				queue = append(queue, successor)
+			}
+		}
+	}
+
+	return order, len(order) == g.Nodes().Len()
+}
@@ -0,0 +1,211 @@
+package graph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+func TestTransitiveReduction(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name string
+		g    *graph.Graph[int]
+		want *graph.Graph[int]
+	}
+	tests := []testCase{
+		{
+			name: "empty graph",
+			g:    directedIntGraphOf(),
+			want: directedIntGraphOf(),
+		},
+		{
+			name: "path",
+			g:    directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+			want: directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+		},
+		{
+			name: "path with shortcut",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(1, 3),
+			),
+			want: directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3)),
+		},
+		{
+			name: "diamond with shortcut",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(1, 3),
+				edgeOf(2, 4),
+				edgeOf(3, 4),
+				edgeOf(1, 4),
+			),
+			want: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(1, 3),
+				edgeOf(2, 4),
+				edgeOf(3, 4),
+			),
+		},
+		{
+			name: "long shortcut over a chain",
+			g: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 4),
+				edgeOf(4, 5),
+				edgeOf(1, 5),
+				edgeOf(2, 5),
+			),
+			want: directedIntGraphOf(
+				edgeOf(1, 2),
+				edgeOf(2, 3),
+				edgeOf(3, 4),
+				edgeOf(4, 5),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := graph.TransitiveReduction(tt.g)
+			if err != nil {
+				t.Fatalf("graph.TransitiveReduction: got error %v", err)
+			}
+			if !graph.Equal[int](got, tt.want) {
+				t.Errorf(
+					"graph.TransitiveReduction: got %v, want %v",
+					got,
+					tt.want,
+				)
+			}
+		})
+	}
+
+	t.Run("keeps isolated nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+		g.AddNode(3)
+
+		got, err := graph.TransitiveReduction(g)
+		if err != nil {
+			t.Fatalf("graph.TransitiveReduction: got error %v", err)
+		}
+		if !graph.Equal[int](got, g) {
+			t.Errorf("graph.TransitiveReduction: got %v, want %v", got, g)
+		}
+	})
+
+	t.Run("returns ErrCycle for a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3), edgeOf(3, 1))
+
+		if _, err := graph.TransitiveReduction(g); !errors.Is(err, graph.ErrCycle) {
+			t.Errorf(
+				"graph.TransitiveReduction: got error %v, want %v",
+				err,
+				graph.ErrCycle,
+			)
+		}
+	})
+
+	t.Run("returns ErrCycle for a self-loop", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().AllowsSelfLoops(true).Build()
+		g.PutEdge(1, 1)
+
+		if _, err := graph.TransitiveReduction(g); !errors.Is(err, graph.ErrCycle) {
+			t.Errorf(
+				"graph.TransitiveReduction: got error %v, want %v",
+				err,
+				graph.ErrCycle,
+			)
+		}
+	})
+
+	t.Run("returns ErrUndirected for an undirected graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := undirectedIntGraphOf(edgeOf(1, 2))
+
+		if _, err := graph.TransitiveReduction(g); !errors.Is(err, graph.ErrUndirected) {
+			t.Errorf(
+				"graph.TransitiveReduction: got error %v, want %v",
+				err,
+				graph.ErrUndirected,
+			)
+		}
+	})
+}
+
+func TestTransitiveReductionRoundTrips(t *testing.T) {
+	t.Parallel()
+
+	for _, allowsSelfLoops := range []bool{false, true} {
+		for seed := range uint64(20) {
+			// Make a random DAG by only putting edges from lower nodes to
+			// higher nodes.
+			r := rand.New(rand.NewPCG(seed, seed))
+			g := graph.Directed[int]().AllowsSelfLoops(allowsSelfLoops).Build()
+			for source := range 15 {
+				g.AddNode(source)
+				for target := source + 1; target < 15; target++ {
+					if r.IntN(3) == 0 {
+						g.PutEdge(source, target)
+					}
+				}
+			}
+
+			reduction, err := graph.TransitiveReduction(g)
+			if err != nil {
+				t.Fatalf("graph.TransitiveReduction: got error %v", err)
+			}
+
+			closure := graph.TransitiveClosure(g)
+			if got := graph.TransitiveClosure(reduction); !graph.Equal[int](got, closure) {
+				t.Errorf(
+					"graph.TransitiveClosure(graph.TransitiveReduction(g)): "+
+						"got %v, want graph.TransitiveClosure(g) of %v",
+					got,
+					closure,
+				)
+			}
+
+			if !allowsSelfLoops {
+				// The transitive closure of g has the same reachability as g,
+				// so it has the same transitive reduction.
+				got, err := graph.TransitiveReduction(closure)
+				if err != nil {
+					t.Fatalf("graph.TransitiveReduction: got error %v", err)
+				}
+				if !graph.Equal[int](got, reduction) {
+					t.Errorf(
+						"graph.TransitiveReduction(graph.TransitiveClosure(g)): "+
+							"got %v, want graph.TransitiveReduction(g) of %v",
+						got,
+						reduction,
+					)
+				}
+			}
+
+			for edge := range reduction.Edges().All() {
+				if !g.HasEdgeConnectingEndpoints(edge) {
+					t.Errorf(
+						"graph.TransitiveReduction: got edge %v, "+
+							"which is not in the original graph",
+						edge,
+					)
+				}
+			}
+		}
+	}
+}
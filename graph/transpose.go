@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/jbduncan/go-containers/set"
+)
+
+// Transpose returns a read-only view of graph g with the direction of every
+// edge reversed. That is, the view's predecessors are g's successors, its
+// in-degrees are g's out-degrees, and every edge a -> b in g is an edge b -> a
+// in the view, and vice versa.
+//
+// No nodes or edges are copied, so changes to g are reflected in the view.
+//
+// If g is undirected, then the view has the same nodes and edges as g.
+func Transpose[N comparable](g GraphView[N]) TransposedGraph[N] {
+	return TransposedGraph[N]{
+		delegate: g,
+	}
+}
+
+type TransposedGraph[N comparable] struct {
+	delegate GraphView[N]
+}
+
+func (t TransposedGraph[N]) IsDirected() bool {
+	return t.delegate.IsDirected()
+}
+
+func (t TransposedGraph[N]) AllowsSelfLoops() bool {
+	return t.delegate.AllowsSelfLoops()
+}
+
+func (t TransposedGraph[N]) Nodes() SetView[N] {
+	return t.delegate.Nodes()
+}
+
+func (t TransposedGraph[N]) Edges() SetView[EndpointPair[N]] {
+	return transposedEdgeSet[N]{
+		delegate: t.delegate.Edges(),
+	}
+}
+
+func (t TransposedGraph[N]) AdjacentNodes(node N) SetView[N] {
+	return t.delegate.AdjacentNodes(node)
+}
+
+func (t TransposedGraph[N]) Predecessors(node N) SetView[N] {
+	return t.delegate.Successors(node)
+}
+
+func (t TransposedGraph[N]) Successors(node N) SetView[N] {
+	return t.delegate.Predecessors(node)
+}
+
+func (t TransposedGraph[N]) IncidentEdges(node N) SetView[EndpointPair[N]] {
+	return transposedEdgeSet[N]{
+		delegate: t.delegate.IncidentEdges(node),
+	}
+}
+
+func (t TransposedGraph[N]) Degree(node N) int {
+	return t.delegate.Degree(node)
+}
+
+func (t TransposedGraph[N]) InDegree(node N) int {
+	return t.delegate.OutDegree(node)
+}
+
+func (t TransposedGraph[N]) OutDegree(node N) int {
+	return t.delegate.InDegree(node)
+}
+
+func (t TransposedGraph[N]) HasEdgeConnecting(source N, target N) bool {
+	return t.delegate.HasEdgeConnecting(target, source)
+}
+
+func (t TransposedGraph[N]) HasEdgeConnectingEndpoints(
+	endpointPair EndpointPair[N],
+) bool {
+	return t.HasEdgeConnecting(endpointPair.Source(), endpointPair.Target())
+}
+
+func (t TransposedGraph[N]) String() string {
+	return stringOf[N](t)
+}
+
+type transposedEdgeSet[N comparable] struct {
+	delegate SetView[EndpointPair[N]]
+}
+
+func (t transposedEdgeSet[N]) Contains(element EndpointPair[N]) bool {
+	return t.delegate.Contains(reverseOf(element))
+}
+
+func (t transposedEdgeSet[N]) Len() int {
+	return t.delegate.Len()
+}
+
+func (t transposedEdgeSet[N]) All() iter.Seq[EndpointPair[N]] {
+	return func(yield func(EndpointPair[N]) bool) {
+		for edge := range t.delegate.All() {
+			if !yield(reverseOf(edge)) {
+				return
+			}
+		}
+	}
+}
+
+func (t transposedEdgeSet[N]) String() string {
+	return set.StringImpl[EndpointPair[N]](t)
+}
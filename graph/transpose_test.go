@@ -0,0 +1,128 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/graph/graphtest"
+)
+
+func TestTransposedGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return newTransposedGraph(graph.Directed[int]().Build())
+		},
+		addNodeToTransposedGraph,
+		putEdgeToTransposedGraph,
+		graphtest.Directed,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestTransposedAllowsSelfLoopsGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return newTransposedGraph(
+				graph.Directed[int]().AllowsSelfLoops(true).Build(),
+			)
+		},
+		addNodeToTransposedGraph,
+		putEdgeToTransposedGraph,
+		graphtest.Directed,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestTranspose(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reverses the edges of the original graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(1, 3))
+		transposed := graph.Transpose(g)
+
+		want := directedIntGraphOf(edgeOf(2, 1), edgeOf(3, 1))
+		if !graph.Equal[int](transposed, want) {
+			t.Errorf("graph.Transpose: got %v, want %v", transposed, want)
+		}
+	})
+
+	t.Run("twice is the original graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(1, 3))
+
+		if got := graph.Transpose(graph.Transpose(g)); !graph.Equal(got, g) {
+			t.Errorf("graph.Transpose: got %v, want %v", got, g)
+		}
+	})
+
+	t.Run("of a filtered graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2), edgeOf(2, 3))
+		filtered := graph.Filter(g, func(node int) bool { return node != 3 }, nil)
+
+		got := graph.Transpose(filtered)
+
+		want := directedIntGraphOf(edgeOf(2, 1))
+		if !graph.Equal(got, want) {
+			t.Errorf("graph.Transpose: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("reflects changes to the original graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := directedIntGraphOf(edgeOf(1, 2))
+		transposed := graph.Transpose(g)
+		g.PutEdge(2, 3)
+		g.RemoveEdge(1, 2)
+
+		want := directedIntGraphOf(edgeOf(3, 2))
+		want.AddNode(1)
+		if !graph.Equal[int](transposed, want) {
+			t.Errorf("graph.Transpose: got %v, want %v", transposed, want)
+		}
+	})
+}
+
+// transposedGraph pairs a transposed view with the graph it is a view of, so
+// that graphtest.TestReadOnly can put edges into the view by putting reversed
+// edges into the original graph.
+type transposedGraph struct {
+	graph.TransposedGraph[int]
+
+	original *graph.Graph[int]
+}
+
+func newTransposedGraph(original *graph.Graph[int]) transposedGraph {
+	return transposedGraph{
+		TransposedGraph: graph.Transpose(original),
+		original:        original,
+	}
+}
+
+func addNodeToTransposedGraph(
+	g graphtest.Graph[int],
+	node int,
+) graphtest.Graph[int] {
+	g.(transposedGraph).original.AddNode(node)
+	return g
+}
+
+func putEdgeToTransposedGraph(
+	g graphtest.Graph[int],
+	source int,
+	target int,
+) graphtest.Graph[int] {
+	g.(transposedGraph).original.PutEdge(target, source)
+	return g
+}
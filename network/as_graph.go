@@ -0,0 +1,186 @@
+package network
+
+import (
+	"iter"
+	"strconv"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+type asGraph[N comparable, E comparable] struct {
+	network *Network[N, E]
+}
+
+func (a asGraph[N, E]) IsDirected() bool {
+	return a.network.IsDirected()
+}
+
+func (a asGraph[N, E]) AllowsSelfLoops() bool {
+	return a.network.AllowsSelfLoops()
+}
+
+func (a asGraph[N, E]) Nodes() graph.SetView[N] {
+	return a.network.Nodes()
+}
+
+func (a asGraph[N, E]) Edges() graph.SetView[graph.EndpointPair[N]] {
+	return asGraphEdgeSet[N, E]{
+		network: a.network,
+	}
+}
+
+func (a asGraph[N, E]) AdjacentNodes(node N) graph.SetView[N] {
+	return a.network.AdjacentNodes(node)
+}
+
+func (a asGraph[N, E]) Predecessors(node N) graph.SetView[N] {
+	return a.network.Predecessors(node)
+}
+
+func (a asGraph[N, E]) Successors(node N) graph.SetView[N] {
+	return a.network.Successors(node)
+}
+
+func (a asGraph[N, E]) IncidentEdges(node N) graph.SetView[graph.EndpointPair[N]] {
+	return asGraphIncidentEdgeSet[N, E]{
+		network: a.network,
+		node:    node,
+	}
+}
+
+func (a asGraph[N, E]) Degree(node N) int {
+	if a.IsDirected() {
+		return a.InDegree(node) + a.OutDegree(node)
+	}
+
+	result := a.AdjacentNodes(node).Len()
+	if a.HasEdgeConnecting(node, node) {
+		result++
+	}
+	return result
+}
+
+func (a asGraph[N, E]) InDegree(node N) int {
+	if !a.IsDirected() {
+		return a.Degree(node)
+	}
+
+	return a.Predecessors(node).Len()
+}
+
+func (a asGraph[N, E]) OutDegree(node N) int {
+	if !a.IsDirected() {
+		return a.Degree(node)
+	}
+
+	return a.Successors(node).Len()
+}
+
+func (a asGraph[N, E]) HasEdgeConnecting(source N, target N) bool {
+	return a.network.HasEdgeConnecting(source, target)
+}
+
+func (a asGraph[N, E]) HasEdgeConnectingEndpoints(
+	endpointPair graph.EndpointPair[N],
+) bool {
+	return a.HasEdgeConnecting(endpointPair.Source(), endpointPair.Target())
+}
+
+func (a asGraph[N, E]) String() string {
+	return "isDirected: " +
+		strconv.FormatBool(a.IsDirected()) +
+		", allowsSelfLoops: " +
+		strconv.FormatBool(a.AllowsSelfLoops()) +
+		", nodes: " +
+		a.Nodes().String() +
+		", edges: " +
+		a.Edges().String()
+}
+
+// asGraphEdgeSet is the set of edges of the graph view of a network, which has
+// one edge for each pair of connected nodes, however many parallel edges
+// connect them.
+type asGraphEdgeSet[N comparable, E comparable] struct {
+	network *Network[N, E]
+}
+
+func (a asGraphEdgeSet[N, E]) Contains(element graph.EndpointPair[N]) bool {
+	return a.network.HasEdgeConnecting(element.Source(), element.Target())
+}
+
+func (a asGraphEdgeSet[N, E]) Len() int {
+	result := 0
+	for range a.All() {
+		result++
+	}
+	return result
+}
+
+func (a asGraphEdgeSet[N, E]) All() iter.Seq[graph.EndpointPair[N]] {
+	return func(yield func(graph.EndpointPair[N]) bool) {
+		// In an undirected network, each pair of connected nodes is only
+		// returned from the first of the two nodes to be visited.
+		visited := set.Of[N]()
+		for node := range a.network.Nodes().All() {
+			for successor := range a.network.Successors(node).All() {
+				if !a.network.IsDirected() && visited.Contains(successor) {
+					continue
+				}
+				if !yield(graph.EndpointPairOf(node, successor)) {
+					return
+				}
+			}
+			visited.Add(node)
+		}
+	}
+}
+
+func (a asGraphEdgeSet[N, E]) String() string {
+	return set.StringImpl[graph.EndpointPair[N]](a)
+}
+
+type asGraphIncidentEdgeSet[N comparable, E comparable] struct {
+	network *Network[N, E]
+	node    N
+}
+
+func (a asGraphIncidentEdgeSet[N, E]) Contains(
+	element graph.EndpointPair[N],
+) bool {
+	return (element.Source() == a.node || element.Target() == a.node) &&
+		a.network.HasEdgeConnecting(element.Source(), element.Target())
+}
+
+func (a asGraphIncidentEdgeSet[N, E]) Len() int {
+	result := 0
+	for range a.All() {
+		result++
+	}
+	return result
+}
+
+func (a asGraphIncidentEdgeSet[N, E]) All() iter.Seq[graph.EndpointPair[N]] {
+	return func(yield func(graph.EndpointPair[N]) bool) {
+		if a.network.IsDirected() {
+			for predecessor := range a.network.Predecessors(a.node).All() {
+				if !yield(graph.EndpointPairOf(predecessor, a.node)) {
+					return
+				}
+			}
+		}
+		for successor := range a.network.Successors(a.node).All() {
+			if a.network.IsDirected() && successor == a.node {
+				// The self-loop was already returned as a predecessor.
+				continue
+			}
+			if !yield(graph.EndpointPairOf(a.node, successor)) {
+				return
+			}
+		}
+	}
+}
+
+func (a asGraphIncidentEdgeSet[N, E]) String() string {
+	return set.StringImpl[graph.EndpointPair[N]](a)
+}
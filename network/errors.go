@@ -0,0 +1,17 @@
+package network
+
+import "errors"
+
+// The errors returned by this package, alongside the errors in package graph
+// like graph.ErrSelfLoopNotAllowed. They are all comparable with errors.Is,
+// even when they are wrapped by a graph.EdgeError.
+var (
+	// ErrParallelEdgeNotAllowed is returned when an edge is added to a
+	// network that disallows parallel edges, but the nodes of the edge are
+	// already connected by another edge.
+	ErrParallelEdgeNotAllowed = errors.New("parallel edges are disallowed")
+
+	// ErrEdgeConnectsOtherNodes is returned when an edge is added to a
+	// network that already has that edge connecting other nodes.
+	ErrEdgeConnectsOtherNodes = errors.New("edge already connects other nodes")
+)
@@ -0,0 +1,506 @@
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+func Undirected[N comparable, E comparable]() Builder[N, E] {
+	return Builder[N, E]{
+		directed:            false,
+		allowsParallelEdges: false,
+		allowsSelfLoops:     false,
+	}
+}
+
+func Directed[N comparable, E comparable]() Builder[N, E] {
+	return Builder[N, E]{
+		directed:            true,
+		allowsParallelEdges: false,
+		allowsSelfLoops:     false,
+	}
+}
+
+type Builder[N comparable, E comparable] struct {
+	directed            bool
+	allowsParallelEdges bool
+	allowsSelfLoops     bool
+}
+
+// AllowsParallelEdges returns a copy of this builder that builds networks
+// which allow more than one edge to connect the same source and target nodes
+// if and only if allowsParallelEdges is true. The default is false.
+func (b Builder[N, E]) AllowsParallelEdges(allowsParallelEdges bool) Builder[N, E] {
+	b.allowsParallelEdges = allowsParallelEdges
+	return b
+}
+
+// AllowsSelfLoops returns a copy of this builder that builds networks which
+// allow an edge to connect a node to itself if and only if allowsSelfLoops is
+// true. The default is false.
+func (b Builder[N, E]) AllowsSelfLoops(allowsSelfLoops bool) Builder[N, E] {
+	b.allowsSelfLoops = allowsSelfLoops
+	return b
+}
+
+func (b Builder[N, E]) Build() *Network[N, E] {
+	return &Network[N, E]{
+		nodeToConnections:   make(map[N]*connections[N, E]),
+		edgeToIncidentNodes: make(map[E]graph.EndpointPair[N]),
+		directed:            b.directed,
+		allowsParallelEdges: b.allowsParallelEdges,
+		allowsSelfLoops:     b.allowsSelfLoops,
+	}
+}
+
+// NetworkView is a read-only network; a set of nodes and a set of edges that
+// connect pairs of those nodes, where, unlike in a graph.GraphView, each edge
+// is a unique value of its own. So a network can have more than one edge
+// connecting the same pair of nodes, which are known as parallel edges.
+//
+// Unless stated otherwise, the set views returned by a NetworkView's methods
+// reflect later changes to the network.
+type NetworkView[N comparable, E comparable] interface {
+	// IsDirected returns true if each edge in this network is directed,
+	// pointing from its source node to its target node, otherwise it returns
+	// false.
+	IsDirected() bool
+
+	// AllowsParallelEdges returns true if this network allows more than one
+	// edge to connect the same source and target nodes, otherwise it returns
+	// false.
+	AllowsParallelEdges() bool
+
+	// AllowsSelfLoops returns true if this network allows an edge to connect
+	// a node to itself, otherwise it returns false.
+	AllowsSelfLoops() bool
+
+	// Nodes returns all the nodes in this network.
+	Nodes() graph.SetView[N]
+
+	// Edges returns all the edges in this network.
+	Edges() graph.SetView[E]
+
+	// AsGraph returns a read-only graph view of this network, with the same
+	// nodes and with an edge from a node a to a node b if and only if this
+	// network has at least one edge from a to b.
+	AsGraph() graph.GraphView[N]
+
+	// AdjacentNodes returns the nodes that share an edge with the given node,
+	// in either direction.
+	AdjacentNodes(node N) graph.SetView[N]
+
+	// Predecessors returns the nodes that have an edge pointing to the given
+	// node. If this network is undirected, it is the same as AdjacentNodes.
+	Predecessors(node N) graph.SetView[N]
+
+	// Successors returns the nodes that the given node has an edge pointing
+	// to. If this network is undirected, it is the same as AdjacentNodes.
+	Successors(node N) graph.SetView[N]
+
+	// IncidentEdges returns the edges that touch the given node.
+	IncidentEdges(node N) graph.SetView[E]
+
+	// InEdges returns the edges that point to the given node. If this network
+	// is undirected, it is the same as IncidentEdges.
+	InEdges(node N) graph.SetView[E]
+
+	// OutEdges returns the edges that point away from the given node. If this
+	// network is undirected, it is the same as IncidentEdges.
+	OutEdges(node N) graph.SetView[E]
+
+	// Degree returns the number of times that an edge touches the given node,
+	// so a self-loop counts twice.
+	Degree(node N) int
+
+	// InDegree returns the number of edges pointing to the given node. If
+	// this network is undirected, it is the same as Degree.
+	InDegree(node N) int
+
+	// OutDegree returns the number of edges pointing away from the given
+	// node. If this network is undirected, it is the same as Degree.
+	OutDegree(node N) int
+
+	// IncidentNodes returns the source and target nodes of the given edge. If
+	// this network is undirected, they are in the order that they were given
+	// to AddEdge.
+	//
+	// IncidentNodes panics if the edge is not in this network.
+	IncidentNodes(edge E) graph.EndpointPair[N]
+
+	// EdgesConnecting returns the edges from source to target. If this
+	// network is undirected, the order of source and target does not matter.
+	EdgesConnecting(source N, target N) graph.SetView[E]
+
+	// HasEdgeConnecting returns true if there is at least one edge from
+	// source to target in this network, otherwise it returns false. If this
+	// network is undirected, the order of source and target does not matter.
+	HasEdgeConnecting(source N, target N) bool
+
+	// String returns a string representation of this network, in the format
+	// "isDirected: <IsDirected>, allowsParallelEdges: <AllowsParallelEdges>,
+	// allowsSelfLoops: <AllowsSelfLoops>, nodes: <Nodes>, edges: {<edge>:
+	// <IncidentNodes>, ...}".
+	//
+	// This method satisfies fmt.Stringer.
+	String() string
+}
+
+// MutableNetwork is a NetworkView with additional methods for adding and
+// removing nodes and edges. It is implemented by Network.
+type MutableNetwork[N comparable, E comparable] interface {
+	NetworkView[N, E]
+
+	// AddNode adds the given node to this network. Returns true if this
+	// network changed as a result of this call, otherwise false.
+	AddNode(node N) bool
+
+	// AddEdge adds the given edge from source to target to this network,
+	// adding the nodes too if they are not already present. Returns true if
+	// this network changed as a result of this call, otherwise false.
+	//
+	// AddEdge panics in the cases where TryAddEdge returns an error.
+	AddEdge(source N, target N, edge E) bool
+
+	// TryAddEdge is like AddEdge, but it returns an error instead of
+	// panicking, and it leaves this network unchanged. The error is a
+	// *graph.EdgeError wrapping:
+	//   - graph.ErrSelfLoopNotAllowed if source and target are the same node
+	//     and this network disallows self-loops.
+	//   - ErrParallelEdgeNotAllowed if source and target are already
+	//     connected by another edge and this network disallows parallel
+	//     edges.
+	//   - ErrEdgeConnectsOtherNodes if the edge is already in this network
+	//     but connects other nodes.
+	TryAddEdge(source N, target N, edge E) (bool, error)
+
+	// RemoveNode removes the given node and all of its incident edges from
+	// this network. Returns true if this network changed as a result of this
+	// call, otherwise false.
+	RemoveNode(node N) bool
+
+	// RemoveEdge removes the given edge from this network, but not the nodes
+	// that it connects. Returns true if this network changed as a result of
+	// this call, otherwise false.
+	RemoveEdge(edge E) bool
+}
+
+type Network[N comparable, E comparable] struct {
+	nodeToConnections   map[N]*connections[N, E]
+	edgeToIncidentNodes map[E]graph.EndpointPair[N]
+	directed            bool
+	allowsParallelEdges bool
+	allowsSelfLoops     bool
+}
+
+// connections holds the edges and neighbors of a node. The neighbor maps count
+// the edges to or from each neighbor, so that parallel edges can be removed
+// one by one. In an undirected network, inEdges and outEdges are the same map,
+// and so are predecessors and successors.
+type connections[N comparable, E comparable] struct {
+	inEdges      map[E]N
+	outEdges     map[E]N
+	predecessors map[N]int
+	successors   map[N]int
+	selfLoops    int
+}
+
+func newConnections[N comparable, E comparable](directed bool) *connections[N, E] {
+	if directed {
+		return &connections[N, E]{
+			inEdges:      make(map[E]N),
+			outEdges:     make(map[E]N),
+			predecessors: make(map[N]int),
+			successors:   make(map[N]int),
+		}
+	}
+
+	incidentEdges := make(map[E]N)
+	adjacentNodes := make(map[N]int)
+	return &connections[N, E]{
+		inEdges:      incidentEdges,
+		outEdges:     incidentEdges,
+		predecessors: adjacentNodes,
+		successors:   adjacentNodes,
+	}
+}
+
+func (n *Network[N, E]) IsDirected() bool {
+	return n.directed
+}
+
+func (n *Network[N, E]) AllowsParallelEdges() bool {
+	return n.allowsParallelEdges
+}
+
+func (n *Network[N, E]) AllowsSelfLoops() bool {
+	return n.allowsSelfLoops
+}
+
+func (n *Network[N, E]) Nodes() graph.SetView[N] {
+	return keySet[N, *connections[N, E]]{
+		keys: func() map[N]*connections[N, E] {
+			return n.nodeToConnections
+		},
+	}
+}
+
+func (n *Network[N, E]) Edges() graph.SetView[E] {
+	return keySet[E, graph.EndpointPair[N]]{
+		keys: func() map[E]graph.EndpointPair[N] {
+			return n.edgeToIncidentNodes
+		},
+	}
+}
+
+func (n *Network[N, E]) AsGraph() graph.GraphView[N] {
+	return asGraph[N, E]{
+		network: n,
+	}
+}
+
+func (n *Network[N, E]) AdjacentNodes(node N) graph.SetView[N] {
+	if !n.directed {
+		return n.Successors(node)
+	}
+
+	return set.Union[N](n.Predecessors(node), n.Successors(node))
+}
+
+func (n *Network[N, E]) Predecessors(node N) graph.SetView[N] {
+	return keySet[N, int]{
+		keys: func() map[N]int {
+			if c, ok := n.nodeToConnections[node]; ok {
+				return c.predecessors
+			}
+			return nil
+		},
+	}
+}
+
+func (n *Network[N, E]) Successors(node N) graph.SetView[N] {
+	return keySet[N, int]{
+		keys: func() map[N]int {
+			if c, ok := n.nodeToConnections[node]; ok {
+				return c.successors
+			}
+			return nil
+		},
+	}
+}
+
+func (n *Network[N, E]) IncidentEdges(node N) graph.SetView[E] {
+	if !n.directed {
+		return n.OutEdges(node)
+	}
+
+	return set.Union[E](n.InEdges(node), n.OutEdges(node))
+}
+
+func (n *Network[N, E]) InEdges(node N) graph.SetView[E] {
+	return keySet[E, N]{
+		keys: func() map[E]N {
+			if c, ok := n.nodeToConnections[node]; ok {
+				return c.inEdges
+			}
+			return nil
+		},
+	}
+}
+
+func (n *Network[N, E]) OutEdges(node N) graph.SetView[E] {
+	return keySet[E, N]{
+		keys: func() map[E]N {
+			if c, ok := n.nodeToConnections[node]; ok {
+				return c.outEdges
+			}
+			return nil
+		},
+	}
+}
+
+func (n *Network[N, E]) Degree(node N) int {
+	c, ok := n.nodeToConnections[node]
+	if !ok {
+		return 0
+	}
+
+	if n.directed {
+		return len(c.inEdges) + len(c.outEdges)
+	}
+	return len(c.inEdges) + c.selfLoops
+}
+
+func (n *Network[N, E]) InDegree(node N) int {
+	if !n.directed {
+		return n.Degree(node)
+	}
+
+	return n.InEdges(node).Len()
+}
+
+func (n *Network[N, E]) OutDegree(node N) int {
+	if !n.directed {
+		return n.Degree(node)
+	}
+
+	return n.OutEdges(node).Len()
+}
+
+func (n *Network[N, E]) IncidentNodes(edge E) graph.EndpointPair[N] {
+	incidentNodes, ok := n.edgeToIncidentNodes[edge]
+	if !ok {
+		panic(fmt.Sprintf("edge %v is not an element of this network", edge))
+	}
+
+	return incidentNodes
+}
+
+func (n *Network[N, E]) EdgesConnecting(source N, target N) graph.SetView[E] {
+	return edgesConnectingSet[N, E]{
+		network: n,
+		source:  source,
+		target:  target,
+	}
+}
+
+func (n *Network[N, E]) HasEdgeConnecting(source N, target N) bool {
+	return n.Successors(source).Contains(target)
+}
+
+func (n *Network[N, E]) String() string {
+	var edges strings.Builder
+	edges.WriteString("{")
+	first := true
+	for edge, incidentNodes := range n.edgeToIncidentNodes {
+		if !first {
+			edges.WriteString(", ")
+		}
+		first = false
+		fmt.Fprintf(&edges, "%v: %v", edge, incidentNodes)
+	}
+	edges.WriteString("}")
+
+	return "isDirected: " +
+		strconv.FormatBool(n.IsDirected()) +
+		", allowsParallelEdges: " +
+		strconv.FormatBool(n.AllowsParallelEdges()) +
+		", allowsSelfLoops: " +
+		strconv.FormatBool(n.AllowsSelfLoops()) +
+		", nodes: " +
+		n.Nodes().String() +
+		", edges: " +
+		edges.String()
+}
+
+func (n *Network[N, E]) AddNode(node N) bool {
+	if _, ok := n.nodeToConnections[node]; ok {
+		return false
+	}
+
+	n.nodeToConnections[node] = newConnections[N, E](n.directed)
+	return true
+}
+
+func (n *Network[N, E]) AddEdge(source N, target N, edge E) bool {
+	added, err := n.TryAddEdge(source, target, edge)
+	if err != nil {
+		panic(err)
+	}
+	return added
+}
+
+func (n *Network[N, E]) TryAddEdge(source N, target N, edge E) (bool, error) {
+	if incidentNodes, ok := n.edgeToIncidentNodes[edge]; ok {
+		if n.connectsSameNodes(incidentNodes, source, target) {
+			return false, nil
+		}
+		return false, edgeError(source, target, ErrEdgeConnectsOtherNodes)
+	}
+	if source == target && !n.allowsSelfLoops {
+		return false, edgeError(source, target, graph.ErrSelfLoopNotAllowed)
+	}
+	if !n.allowsParallelEdges && n.HasEdgeConnecting(source, target) {
+		return false, edgeError(source, target, ErrParallelEdgeNotAllowed)
+	}
+
+	n.AddNode(source)
+	n.AddNode(target)
+	sourceConnections := n.nodeToConnections[source]
+	targetConnections := n.nodeToConnections[target]
+
+	sourceConnections.outEdges[edge] = target
+	sourceConnections.successors[target]++
+	targetConnections.inEdges[edge] = source
+	targetConnections.predecessors[source]++
+	if source == target {
+		sourceConnections.selfLoops++
+	}
+	n.edgeToIncidentNodes[edge] = graph.EndpointPairOf(source, target)
+	return true, nil
+}
+
+func (n *Network[N, E]) connectsSameNodes(
+	incidentNodes graph.EndpointPair[N],
+	source N,
+	target N,
+) bool {
+	if incidentNodes.Source() == source && incidentNodes.Target() == target {
+		return true
+	}
+	return !n.directed &&
+		incidentNodes.Source() == target &&
+		incidentNodes.Target() == source
+}
+
+func edgeError[N comparable](source N, target N, err error) error {
+	return &graph.EdgeError[N]{
+		Source: source,
+		Target: target,
+		Err:    err,
+	}
+}
+
+func (n *Network[N, E]) RemoveNode(node N) bool {
+	if _, ok := n.nodeToConnections[node]; !ok {
+		return false
+	}
+
+	for _, edge := range copyOf(n.IncidentEdges(node)) {
+		n.RemoveEdge(edge)
+	}
+	delete(n.nodeToConnections, node)
+	return true
+}
+
+func (n *Network[N, E]) RemoveEdge(edge E) bool {
+	incidentNodes, ok := n.edgeToIncidentNodes[edge]
+	if !ok {
+		return false
+	}
+
+	source, target := incidentNodes.Source(), incidentNodes.Target()
+	sourceConnections := n.nodeToConnections[source]
+	targetConnections := n.nodeToConnections[target]
+
+	delete(sourceConnections.outEdges, edge)
+	decrement(sourceConnections.successors, target)
+	delete(targetConnections.inEdges, edge)
+	decrement(targetConnections.predecessors, source)
+	if source == target {
+		sourceConnections.selfLoops--
+	}
+	delete(n.edgeToIncidentNodes, edge)
+	return true
+}
+
+func decrement[N comparable](counts map[N]int, key N) {
+	counts[key]--
+	if counts[key] == 0 {
+		delete(counts, key)
+	}
+}
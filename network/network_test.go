@@ -0,0 +1,69 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph/graphtest"
+	"github.com/jbduncan/go-containers/network"
+	"github.com/jbduncan/go-containers/network/networktest"
+)
+
+func TestNetwork(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name              string
+		builder           network.Builder[int, string]
+		directionMode     graphtest.DirectionMode
+		selfLoopsMode     graphtest.SelfLoopsMode
+		parallelEdgesMode networktest.ParallelEdgesMode
+	}
+	var tests []testCase
+	for _, directionMode := range []graphtest.DirectionMode{
+		graphtest.Directed,
+		graphtest.Undirected,
+	} {
+		for _, selfLoopsMode := range []graphtest.SelfLoopsMode{
+			graphtest.AllowsSelfLoops,
+			graphtest.DisallowsSelfLoops,
+		} {
+			for _, parallelEdgesMode := range []networktest.ParallelEdgesMode{
+				networktest.AllowsParallelEdges,
+				networktest.DisallowsParallelEdges,
+			} {
+				builder := network.Undirected[int, string]()
+				if directionMode == graphtest.Directed {
+					builder = network.Directed[int, string]()
+				}
+				tests = append(tests, testCase{
+					name: directionMode.String() + "/" +
+						selfLoopsMode.String() + "/" +
+						parallelEdgesMode.String(),
+					builder: builder.
+						AllowsSelfLoops(selfLoopsMode == graphtest.AllowsSelfLoops).
+						AllowsParallelEdges(
+							parallelEdgesMode == networktest.AllowsParallelEdges,
+						),
+					directionMode:     directionMode,
+					selfLoopsMode:     selfLoopsMode,
+					parallelEdgesMode: parallelEdgesMode,
+				})
+			}
+		}
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			networktest.TestMutable(
+				t,
+				func() network.MutableNetwork[int, string] {
+					return tt.builder.Build()
+				},
+				tt.directionMode,
+				tt.selfLoopsMode,
+				tt.parallelEdgesMode,
+			)
+		})
+	}
+}
@@ -0,0 +1,764 @@
+package networktest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/graph/graphtest"
+	internalsettest "github.com/jbduncan/go-containers/internal/settest"
+	"github.com/jbduncan/go-containers/network"
+)
+
+const (
+	node1          = 1
+	node2          = 2
+	node3          = 3
+	nodeNotInGraph = 1_000
+
+	edge12         = "1-2"
+	edge12Parallel = "1-2'"
+	edge13         = "1-3"
+	edge11         = "1-1"
+	edgeNotInGraph = "absent"
+)
+
+//go:generate mise x -- stringer -type=ParallelEdgesMode
+type ParallelEdgesMode int
+
+const (
+	AllowsParallelEdges ParallelEdgesMode = iota
+	DisallowsParallelEdges
+)
+
+// TestMutable runs a suite of test cases for network.MutableNetwork
+// implementations. MutableNetwork instances created for testing are to have
+// int nodes and string edges.
+//
+// Test cases that should be handled similarly in any network implementation
+// are included in this function; for example, testing that the Edges method
+// returns the set of the edges in the network. The graph view returned by
+// AsGraph is tested with graphtest.TestReadOnly. Details of specific
+// implementations of the network.MutableNetwork interface are not tested.
+//
+// Parameter `emptyNetwork` should always return a newly-initialized empty
+// network with no nodes and no edges. Otherwise, the behaviour of this
+// function is undefined.
+func TestMutable(
+	t *testing.T,
+	emptyNetwork func() network.MutableNetwork[int, string],
+	directionMode graphtest.DirectionMode,
+	selfLoopsMode graphtest.SelfLoopsMode,
+	parallelEdgesMode ParallelEdgesMode,
+) {
+	if directionMode != graphtest.Directed &&
+		directionMode != graphtest.Undirected {
+		t.Fatalf(
+			"directionMode expected to be Directed or Undirected "+
+				"but was %v",
+			directionMode,
+		)
+	}
+	if selfLoopsMode != graphtest.AllowsSelfLoops &&
+		selfLoopsMode != graphtest.DisallowsSelfLoops {
+		t.Fatalf(
+			"selfLoopsMode expected to be AllowsSelfLoops or "+
+				"DisallowsSelfLoops but was %v",
+			selfLoopsMode,
+		)
+	}
+	if parallelEdgesMode != AllowsParallelEdges &&
+		parallelEdgesMode != DisallowsParallelEdges {
+		t.Fatalf(
+			"parallelEdgesMode expected to be AllowsParallelEdges or "+
+				"DisallowsParallelEdges but was %v",
+			parallelEdgesMode,
+		)
+	}
+
+	tester{
+		t:                   t,
+		emptyNetwork:        emptyNetwork,
+		directionMode:       directionMode,
+		selfLoopsMode:       selfLoopsMode,
+		directed:            directionMode == graphtest.Directed,
+		allowsSelfLoops:     selfLoopsMode == graphtest.AllowsSelfLoops,
+		allowsParallelEdges: parallelEdgesMode == AllowsParallelEdges,
+	}.test()
+}
+
+type tester struct {
+	t                   *testing.T
+	emptyNetwork        func() network.MutableNetwork[int, string]
+	directionMode       graphtest.DirectionMode
+	selfLoopsMode       graphtest.SelfLoopsMode
+	directed            bool
+	allowsSelfLoops     bool
+	allowsParallelEdges bool
+}
+
+func (tt tester) test() {
+	tt.testProperties()
+
+	tt.testEmptyNetwork()
+
+	tt.testNetworkWithOneEdge()
+
+	tt.testNetworkWithTwoEdgesWithSameSourceNode()
+
+	tt.testAddingEdges()
+
+	tt.testRemovingNodesAndEdges()
+
+	if tt.allowsParallelEdges {
+		tt.testParallelEdgeAllowingNetwork()
+	} else {
+		tt.testParallelEdgeDisallowingNetwork()
+	}
+
+	if tt.allowsSelfLoops {
+		tt.testSelfLoopingNetwork()
+	} else {
+		tt.testSelfLoopDisallowingNetwork()
+	}
+
+	tt.testStringRepresentation()
+
+	tt.testAsGraph()
+}
+
+func (tt tester) testProperties() {
+	tt.t.Run("network properties", func(t *testing.T) {
+		n := tt.emptyNetwork()
+
+		if got, want := n.IsDirected(), tt.directed; got != want {
+			t.Errorf("Network.IsDirected: got %t, want %t", got, want)
+		}
+		if got, want := n.AllowsSelfLoops(), tt.allowsSelfLoops; got != want {
+			t.Errorf("Network.AllowsSelfLoops: got %t, want %t", got, want)
+		}
+		if got, want := n.AllowsParallelEdges(), tt.allowsParallelEdges; got != want {
+			t.Errorf("Network.AllowsParallelEdges: got %t, want %t", got, want)
+		}
+	})
+}
+
+func (tt tester) testEmptyNetwork() {
+	tt.t.Run("empty network", func(t *testing.T) {
+		t.Run("has no nodes", func(t *testing.T) {
+			testSet(t, "Network.Nodes", tt.emptyNetwork().Nodes())
+		})
+
+		t.Run("has no edges", func(t *testing.T) {
+			testSet(t, "Network.Edges", tt.emptyNetwork().Edges())
+		})
+
+		t.Run("has no edges for an absent node", func(t *testing.T) {
+			n := tt.emptyNetwork()
+
+			testSet(t, "Network.IncidentEdges", n.IncidentEdges(nodeNotInGraph))
+			testSet(t, "Network.InEdges", n.InEdges(nodeNotInGraph))
+			testSet(t, "Network.OutEdges", n.OutEdges(nodeNotInGraph))
+			testSet(
+				t,
+				"Network.EdgesConnecting",
+				n.EdgesConnecting(nodeNotInGraph, nodeNotInGraph),
+			)
+		})
+
+		t.Run("has a degree of 0 for an absent node", func(t *testing.T) {
+			n := tt.emptyNetwork()
+
+			testDegrees(t, n, nodeNotInGraph, 0, 0, 0)
+		})
+
+		t.Run("panics on the incident nodes of an absent edge", func(t *testing.T) {
+			defer func() { _ = recover() }()
+			tt.emptyNetwork().IncidentNodes(edgeNotInGraph)
+			t.Errorf("Network.IncidentNodes: should have panicked")
+		})
+
+		t.Run("has set views that reflect later changes", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			nodes := n.Nodes()
+			edges := n.Edges()
+			outEdges := n.OutEdges(node1)
+			successors := n.Successors(node1)
+
+			n.AddEdge(node1, node2, edge12)
+
+			testSet(t, "Network.Nodes", nodes, node1, node2)
+			testSet(t, "Network.Edges", edges, edge12)
+			testSet(t, "Network.OutEdges", outEdges, edge12)
+			testSet(t, "Network.Successors", successors, node2)
+		})
+	})
+}
+
+func (tt tester) testNetworkWithOneEdge() {
+	tt.t.Run("network with one edge", func(t *testing.T) {
+		n := func() network.MutableNetwork[int, string] {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+			return n
+		}
+
+		t.Run("has both nodes", func(t *testing.T) {
+			testSet(t, "Network.Nodes", n().Nodes(), node1, node2)
+		})
+
+		t.Run("has the edge", func(t *testing.T) {
+			testSet(t, "Network.Edges", n().Edges(), edge12)
+		})
+
+		t.Run("has the incident nodes of the edge", func(t *testing.T) {
+			want := graph.EndpointPairOf(node1, node2)
+			if got := n().IncidentNodes(edge12); got != want {
+				t.Errorf("Network.IncidentNodes: got %v, want %v", got, want)
+			}
+		})
+
+		t.Run("both nodes have the edge as an incident edge", func(t *testing.T) {
+			testSet(t, "Network.IncidentEdges", n().IncidentEdges(node1), edge12)
+			testSet(t, "Network.IncidentEdges", n().IncidentEdges(node2), edge12)
+		})
+
+		t.Run("the source node has the edge as an out-edge", func(t *testing.T) {
+			testSet(t, "Network.OutEdges", n().OutEdges(node1), edge12)
+			testSet(t, "Network.InEdges", n().InEdges(node1), ifUndirected(tt, edge12)...)
+		})
+
+		t.Run("the target node has the edge as an in-edge", func(t *testing.T) {
+			testSet(t, "Network.InEdges", n().InEdges(node2), edge12)
+			testSet(t, "Network.OutEdges", n().OutEdges(node2), ifUndirected(tt, edge12)...)
+		})
+
+		t.Run("has the edge connecting the nodes", func(t *testing.T) {
+			testSet(
+				t,
+				"Network.EdgesConnecting",
+				n().EdgesConnecting(node1, node2),
+				edge12,
+			)
+			testSet(
+				t,
+				"Network.EdgesConnecting",
+				n().EdgesConnecting(node2, node1),
+				ifUndirected(tt, edge12)...,
+			)
+			testSet(t, "Network.EdgesConnecting", n().EdgesConnecting(node1, node3))
+		})
+
+		t.Run("has an edge connecting the nodes", func(t *testing.T) {
+			if !n().HasEdgeConnecting(node1, node2) {
+				t.Errorf("Network.HasEdgeConnecting: got false, want true")
+			}
+			if got, want := n().HasEdgeConnecting(node2, node1), !tt.directed; got != want {
+				t.Errorf("Network.HasEdgeConnecting: got %t, want %t", got, want)
+			}
+		})
+
+		t.Run("the nodes are adjacent", func(t *testing.T) {
+			testSet(t, "Network.AdjacentNodes", n().AdjacentNodes(node1), node2)
+			testSet(t, "Network.AdjacentNodes", n().AdjacentNodes(node2), node1)
+			testSet(t, "Network.Successors", n().Successors(node1), node2)
+			testSet(t, "Network.Predecessors", n().Predecessors(node2), node1)
+			testSet(
+				t,
+				"Network.Successors",
+				n().Successors(node2),
+				ifUndirected(tt, node1)...,
+			)
+			testSet(
+				t,
+				"Network.Predecessors",
+				n().Predecessors(node1),
+				ifUndirected(tt, node2)...,
+			)
+		})
+
+		t.Run("the nodes have a degree of 1", func(t *testing.T) {
+			if tt.directed {
+				testDegrees(t, n(), node1, 1, 0, 1)
+				testDegrees(t, n(), node2, 1, 1, 0)
+			} else {
+				testDegrees(t, n(), node1, 1, 1, 1)
+				testDegrees(t, n(), node2, 1, 1, 1)
+			}
+		})
+	})
+}
+
+func (tt tester) testNetworkWithTwoEdgesWithSameSourceNode() {
+	tt.t.Run("network with two edges with the same source node", func(t *testing.T) {
+		n := tt.emptyNetwork()
+		n.AddEdge(node1, node2, edge12)
+		n.AddEdge(node1, node3, edge13)
+
+		testSet(t, "Network.Edges", n.Edges(), edge12, edge13)
+		testSet(t, "Network.OutEdges", n.OutEdges(node1), edge12, edge13)
+		testSet(t, "Network.IncidentEdges", n.IncidentEdges(node1), edge12, edge13)
+		testSet(t, "Network.Successors", n.Successors(node1), node2, node3)
+		testSet(t, "Network.EdgesConnecting", n.EdgesConnecting(node1, node3), edge13)
+		if tt.directed {
+			testDegrees(t, n, node1, 2, 0, 2)
+		} else {
+			testDegrees(t, n, node1, 2, 2, 2)
+		}
+	})
+}
+
+func (tt tester) testAddingEdges() {
+	tt.t.Run("adding edges", func(t *testing.T) {
+		t.Run("adding a new node returns true", func(t *testing.T) {
+			if got := tt.emptyNetwork().AddNode(node1); !got {
+				t.Errorf("MutableNetwork.AddNode: got false, want true")
+			}
+		})
+
+		t.Run("adding an existing node returns false", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddNode(node1)
+
+			if got := n.AddNode(node1); got {
+				t.Errorf("MutableNetwork.AddNode: got true, want false")
+			}
+		})
+
+		t.Run("adding a new edge returns true", func(t *testing.T) {
+			got, err := tt.emptyNetwork().TryAddEdge(node1, node2, edge12)
+
+			if err != nil {
+				t.Fatalf("MutableNetwork.TryAddEdge: got error %v, want nil", err)
+			}
+			if !got {
+				t.Errorf("MutableNetwork.TryAddEdge: got false, want true")
+			}
+		})
+
+		t.Run("adding an existing edge returns false", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+
+			if got := n.AddEdge(node1, node2, edge12); got {
+				t.Errorf("MutableNetwork.AddEdge: got true, want false")
+			}
+			testSet(t, "Network.Edges", n.Edges(), edge12)
+		})
+
+		t.Run("adding an existing edge in reverse", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+
+			got, err := n.TryAddEdge(node2, node1, edge12)
+
+			if tt.directed {
+				testError(t, err, network.ErrEdgeConnectsOtherNodes)
+			} else if err != nil {
+				t.Fatalf("MutableNetwork.TryAddEdge: got error %v, want nil", err)
+			}
+			if got {
+				t.Errorf("MutableNetwork.TryAddEdge: got true, want false")
+			}
+			testSet(t, "Network.Edges", n.Edges(), edge12)
+		})
+
+		t.Run(
+			"adding an existing edge between other nodes returns an error",
+			func(t *testing.T) {
+				n := tt.emptyNetwork()
+				n.AddEdge(node1, node2, edge12)
+
+				got, err := n.TryAddEdge(node1, node3, edge12)
+
+				testError(t, err, network.ErrEdgeConnectsOtherNodes)
+				if got {
+					t.Errorf("MutableNetwork.TryAddEdge: got true, want false")
+				}
+				testSet(t, "Network.Nodes", n.Nodes(), node1, node2)
+				want := graph.EndpointPairOf(node1, node2)
+				if got := n.IncidentNodes(edge12); got != want {
+					t.Errorf("Network.IncidentNodes: got %v, want %v", got, want)
+				}
+			},
+		)
+
+		t.Run(
+			"adding an existing edge between other nodes panics",
+			func(t *testing.T) {
+				n := tt.emptyNetwork()
+				n.AddEdge(node1, node2, edge12)
+
+				defer func() { _ = recover() }()
+				n.AddEdge(node1, node3, edge12)
+				t.Errorf("MutableNetwork.AddEdge: should have panicked")
+			},
+		)
+	})
+}
+
+func (tt tester) testRemovingNodesAndEdges() {
+	tt.t.Run("removing nodes and edges", func(t *testing.T) {
+		t.Run("removing an existing edge", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+			n.AddEdge(node1, node3, edge13)
+
+			if got := n.RemoveEdge(edge12); !got {
+				t.Errorf("MutableNetwork.RemoveEdge: got false, want true")
+			}
+			testSet(t, "Network.Nodes", n.Nodes(), node1, node2, node3)
+			testSet(t, "Network.Edges", n.Edges(), edge13)
+			testSet(t, "Network.IncidentEdges", n.IncidentEdges(node2))
+			testSet(t, "Network.AdjacentNodes", n.AdjacentNodes(node1), node3)
+			if n.HasEdgeConnecting(node1, node2) {
+				t.Errorf("Network.HasEdgeConnecting: got true, want false")
+			}
+		})
+
+		t.Run("removing an absent edge returns false", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+
+			if got := n.RemoveEdge(edgeNotInGraph); got {
+				t.Errorf("MutableNetwork.RemoveEdge: got true, want false")
+			}
+			testSet(t, "Network.Edges", n.Edges(), edge12)
+		})
+
+		t.Run("removing an existing node", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+			n.AddEdge(node1, node3, edge13)
+
+			if got := n.RemoveNode(node1); !got {
+				t.Errorf("MutableNetwork.RemoveNode: got false, want true")
+			}
+			testSet(t, "Network.Nodes", n.Nodes(), node2, node3)
+			testSet(t, "Network.Edges", n.Edges())
+			testSet(t, "Network.IncidentEdges", n.IncidentEdges(node2))
+			testSet(t, "Network.AdjacentNodes", n.AdjacentNodes(node3))
+		})
+
+		t.Run("removing an absent node returns false", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddNode(node1)
+
+			if got := n.RemoveNode(nodeNotInGraph); got {
+				t.Errorf("MutableNetwork.RemoveNode: got true, want false")
+			}
+			testSet(t, "Network.Nodes", n.Nodes(), node1)
+		})
+	})
+}
+
+func (tt tester) testParallelEdgeAllowingNetwork() {
+	tt.t.Run("parallel-edge-allowing network", func(t *testing.T) {
+		n := func() network.MutableNetwork[int, string] {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+			n.AddEdge(node1, node2, edge12Parallel)
+			return n
+		}
+
+		t.Run("has both parallel edges", func(t *testing.T) {
+			testSet(t, "Network.Edges", n().Edges(), edge12, edge12Parallel)
+			testSet(
+				t,
+				"Network.EdgesConnecting",
+				n().EdgesConnecting(node1, node2),
+				edge12,
+				edge12Parallel,
+			)
+			testSet(
+				t,
+				"Network.OutEdges",
+				n().OutEdges(node1),
+				edge12,
+				edge12Parallel,
+			)
+		})
+
+		t.Run("counts both parallel edges in the degree", func(t *testing.T) {
+			if tt.directed {
+				testDegrees(t, n(), node1, 2, 0, 2)
+			} else {
+				testDegrees(t, n(), node1, 2, 2, 2)
+			}
+		})
+
+		t.Run("has one successor for both parallel edges", func(t *testing.T) {
+			testSet(t, "Network.Successors", n().Successors(node1), node2)
+		})
+
+		t.Run(
+			"removing one parallel edge leaves the other connecting the nodes",
+			func(t *testing.T) {
+				n := n()
+				n.RemoveEdge(edge12)
+
+				if !n.HasEdgeConnecting(node1, node2) {
+					t.Errorf("Network.HasEdgeConnecting: got false, want true")
+				}
+				testSet(t, "Network.Successors", n.Successors(node1), node2)
+				testSet(
+					t,
+					"Network.EdgesConnecting",
+					n.EdgesConnecting(node1, node2),
+					edge12Parallel,
+				)
+			},
+		)
+
+		t.Run("has one graph edge for both parallel edges", func(t *testing.T) {
+			edges := n().AsGraph().Edges()
+
+			internalsettest.Len(t, "Network.AsGraph.Edges", edges, 1)
+		})
+	})
+}
+
+func (tt tester) testParallelEdgeDisallowingNetwork() {
+	tt.t.Run("parallel-edge-disallowing network", func(t *testing.T) {
+		t.Run("adding a parallel edge returns an error", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+
+			got, err := n.TryAddEdge(node1, node2, edge12Parallel)
+
+			testError(t, err, network.ErrParallelEdgeNotAllowed)
+			if got {
+				t.Errorf("MutableNetwork.TryAddEdge: got true, want false")
+			}
+			testSet(t, "Network.Edges", n.Edges(), edge12)
+		})
+
+		t.Run("adding a parallel edge panics", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+
+			defer func() { _ = recover() }()
+			n.AddEdge(node1, node2, edge12Parallel)
+			t.Errorf("MutableNetwork.AddEdge: should have panicked")
+		})
+
+		t.Run("adding an anti-parallel edge", func(t *testing.T) {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node2, edge12)
+
+			got, err := n.TryAddEdge(node2, node1, edge12Parallel)
+
+			if tt.directed {
+				if err != nil {
+					t.Fatalf("MutableNetwork.TryAddEdge: got error %v, want nil", err)
+				}
+				if !got {
+					t.Errorf("MutableNetwork.TryAddEdge: got false, want true")
+				}
+			} else {
+				testError(t, err, network.ErrParallelEdgeNotAllowed)
+			}
+		})
+	})
+}
+
+func (tt tester) testSelfLoopingNetwork() {
+	tt.t.Run("self-looping network", func(t *testing.T) {
+		n := func() network.MutableNetwork[int, string] {
+			n := tt.emptyNetwork()
+			n.AddEdge(node1, node1, edge11)
+			return n
+		}
+
+		t.Run("has the self-loop", func(t *testing.T) {
+			testSet(t, "Network.Nodes", n().Nodes(), node1)
+			testSet(t, "Network.Edges", n().Edges(), edge11)
+			testSet(t, "Network.IncidentEdges", n().IncidentEdges(node1), edge11)
+			testSet(t, "Network.InEdges", n().InEdges(node1), edge11)
+			testSet(t, "Network.OutEdges", n().OutEdges(node1), edge11)
+			testSet(
+				t,
+				"Network.EdgesConnecting",
+				n().EdgesConnecting(node1, node1),
+				edge11,
+			)
+		})
+
+		t.Run("the node is adjacent to itself", func(t *testing.T) {
+			testSet(t, "Network.AdjacentNodes", n().AdjacentNodes(node1), node1)
+			testSet(t, "Network.Successors", n().Successors(node1), node1)
+			testSet(t, "Network.Predecessors", n().Predecessors(node1), node1)
+		})
+
+		t.Run("counts the self-loop twice in the degree", func(t *testing.T) {
+			if tt.directed {
+				testDegrees(t, n(), node1, 2, 1, 1)
+			} else {
+				testDegrees(t, n(), node1, 2, 2, 2)
+			}
+		})
+
+		t.Run("removing the self-loop", func(t *testing.T) {
+			n := n()
+			n.RemoveEdge(edge11)
+
+			testSet(t, "Network.Nodes", n.Nodes(), node1)
+			testSet(t, "Network.IncidentEdges", n.IncidentEdges(node1))
+			testSet(t, "Network.AdjacentNodes", n.AdjacentNodes(node1))
+			testDegrees(t, n, node1, 0, 0, 0)
+		})
+
+		t.Run("removing the self-looping node", func(t *testing.T) {
+			n := n()
+			n.RemoveNode(node1)
+
+			testSet(t, "Network.Nodes", n.Nodes())
+			testSet(t, "Network.Edges", n.Edges())
+		})
+	})
+}
+
+func (tt tester) testSelfLoopDisallowingNetwork() {
+	tt.t.Run("self-loop-disallowing network", func(t *testing.T) {
+		t.Run("adding a self-loop returns an error", func(t *testing.T) {
+			n := tt.emptyNetwork()
+
+			got, err := n.TryAddEdge(node1, node1, edge11)
+
+			testError(t, err, graph.ErrSelfLoopNotAllowed)
+			if got {
+				t.Errorf("MutableNetwork.TryAddEdge: got true, want false")
+			}
+			testSet(t, "Network.Nodes", n.Nodes())
+			testSet(t, "Network.Edges", n.Edges())
+		})
+
+		t.Run("adding a self-loop panics", func(t *testing.T) {
+			defer func() { _ = recover() }()
+			tt.emptyNetwork().AddEdge(node1, node1, edge11)
+			t.Errorf("MutableNetwork.AddEdge: should have panicked")
+		})
+	})
+}
+
+func (tt tester) testStringRepresentation() {
+	tt.t.Run("network string representation", func(t *testing.T) {
+		n := tt.emptyNetwork()
+		n.AddEdge(node1, node2, edge12)
+
+		prefix := fmt.Sprintf(
+			"isDirected: %t, allowsParallelEdges: %t, allowsSelfLoops: %t, ",
+			tt.directed,
+			tt.allowsParallelEdges,
+			tt.allowsSelfLoops,
+		)
+		suffix := ", edges: {1-2: <1 -> 2>}"
+		got := n.String()
+		if want1, want2 := prefix+"nodes: [1, 2]"+suffix, prefix+"nodes: [2, 1]"+suffix; got != want1 && got != want2 {
+			t.Errorf("Network.String: got %q, want %q", got, want1)
+		}
+	})
+}
+
+func (tt tester) testAsGraph() {
+	tt.t.Run("graph view", func(t *testing.T) {
+		graphtest.TestReadOnly(
+			t,
+			func() graphtest.Graph[int] {
+				n := tt.emptyNetwork()
+				return networkGraph{
+					GraphView: n.AsGraph(),
+					network:   n,
+				}
+			},
+			func(g graphtest.Graph[int], node int) graphtest.Graph[int] {
+				g.(networkGraph).network.AddNode(node)
+				return g
+			},
+			func(g graphtest.Graph[int], source int, target int) graphtest.Graph[int] {
+				n := g.(networkGraph).network
+				if n.HasEdgeConnecting(source, target) {
+					return g
+				}
+				edge := fmt.Sprintf("%d-%d", source, target)
+				n.AddEdge(source, target, edge)
+				if n.AllowsParallelEdges() {
+					// The graph view should only have one edge for both.
+					n.AddEdge(source, target, edge+"'")
+				}
+				return g
+			},
+			tt.directionMode,
+			tt.selfLoopsMode,
+		)
+	})
+}
+
+// networkGraph pairs the graph view of a network with the network itself, so
+// that graphtest.TestReadOnly can add nodes and edges to the view by adding
+// them to the network.
+type networkGraph struct {
+	graph.GraphView[int]
+
+	network network.MutableNetwork[int, string]
+}
+
+// ifUndirected returns the given elements if the network under test is
+// undirected, otherwise it returns no elements.
+func ifUndirected[T any](tt tester, elements ...T) []T {
+	if tt.directed {
+		return nil
+	}
+	return elements
+}
+
+func testSet[T comparable](
+	t *testing.T,
+	setName string,
+	s graph.SetView[T],
+	expectedElements ...T,
+) {
+	t.Helper()
+
+	internalsettest.Len(t, setName, s, len(expectedElements))
+	internalsettest.All(t, setName, s, expectedElements)
+	internalsettest.Contains(t, setName, s, expectedElements)
+	internalsettest.String(t, setName, s, expectedElements)
+}
+
+func testDegrees(
+	t *testing.T,
+	n network.NetworkView[int, string],
+	node int,
+	expectedDegree int,
+	expectedInDegree int,
+	expectedOutDegree int,
+) {
+	t.Helper()
+
+	if got := n.Degree(node); got != expectedDegree {
+		t.Errorf("Network.Degree: got %d, want %d", got, expectedDegree)
+	}
+	if got := n.InDegree(node); got != expectedInDegree {
+		t.Errorf("Network.InDegree: got %d, want %d", got, expectedInDegree)
+	}
+	if got := n.OutDegree(node); got != expectedOutDegree {
+		t.Errorf("Network.OutDegree: got %d, want %d", got, expectedOutDegree)
+	}
+}
+
+func testError(t *testing.T, err error, want error) {
+	t.Helper()
+
+	if !errors.Is(err, want) {
+		t.Fatalf("MutableNetwork.TryAddEdge: got error %v, want %v", err, want)
+	}
+	var edgeErr *graph.EdgeError[int]
+	if !errors.As(err, &edgeErr) {
+		t.Errorf(
+			"MutableNetwork.TryAddEdge: got error %v, want a *graph.EdgeError",
+			err,
+		)
+	}
+}
@@ -0,0 +1,89 @@
+package network
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+// keySet is a set view of the keys of the map returned by keys, which may be
+// nil. The map is looked up again by every method, so that the set reflects
+// maps that are made or deleted after the set itself is made.
+type keySet[K comparable, V any] struct {
+	keys func() map[K]V
+}
+
+func (k keySet[K, V]) Contains(element K) bool {
+	_, ok := k.keys()[element]
+	return ok
+}
+
+func (k keySet[K, V]) Len() int {
+	return len(k.keys())
+}
+
+func (k keySet[K, V]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range k.keys() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func (k keySet[K, V]) String() string {
+	return set.StringImpl[K](k)
+}
+
+type edgesConnectingSet[N comparable, E comparable] struct {
+	network *Network[N, E]
+	source  N
+	target  N
+}
+
+func (e edgesConnectingSet[N, E]) outEdges() map[E]N {
+	if c, ok := e.network.nodeToConnections[e.source]; ok {
+		return c.outEdges
+	}
+	return nil
+}
+
+func (e edgesConnectingSet[N, E]) Contains(element E) bool {
+	target, ok := e.outEdges()[element]
+	return ok && target == e.target
+}
+
+func (e edgesConnectingSet[N, E]) Len() int {
+	result := 0
+	for range e.All() {
+		result++
+	}
+	return result
+}
+
+func (e edgesConnectingSet[N, E]) All() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		if !e.network.HasEdgeConnecting(e.source, e.target) {
+			return
+		}
+		for edge, target := range e.outEdges() {
+			if target != e.target {
+				continue
+			}
+			if !yield(edge) {
+				return
+			}
+		}
+	}
+}
+
+func (e edgesConnectingSet[N, E]) String() string {
+	return set.StringImpl[E](e)
+}
+
+func copyOf[T comparable](s graph.SetView[T]) []T {
+	return slices.Collect(s.All())
+}
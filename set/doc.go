@@ -1,7 +1,7 @@
 // Package set provides a set data structure, which is a generic, unordered container of elements where no two elements
 // can be equal according to Go's == operator.
 //
-// A mutable Set can be created with Of.
+// A mutable Set can be created with Of, or with WithCapacity when the number of elements is known in advance.
 //
 // The union of two sets can be created with Union.
 //
@@ -18,6 +18,15 @@ func Of[T comparable](elements ...T) Set[T] {
 	}
 }
 
+// WithCapacity returns a new non-nil, empty Set with enough space for at least
+// the given number of elements before it needs to grow, like
+// make(map[T]struct{}, capacity). It panics if capacity is negative.
+func WithCapacity[T comparable](capacity int) Set[T] {
+	return Set[T]{
+		delegate: make(map[T]struct{}, capacity),
+	}
+}
+
 // Set is a generic, unordered collection of unique elements. Its
 // implementation is based on a Go map, with similar performance
 // characteristics.
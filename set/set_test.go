@@ -26,3 +26,15 @@ func TestSetInitializedWithAdd(t *testing.T) {
 		return s
 	})
 }
+
+func TestSetWithCapacity(t *testing.T) {
+	t.Parallel()
+
+	settest.TestMutable(t, func(elements []int) settest.MutableSet[int] {
+		s := set.WithCapacity[int](len(elements))
+		for _, element := range elements {
+			s.Add(element)
+		}
+		return s
+	})
+}
@@ -0,0 +1,113 @@
+package weightedgraph
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+// AStar returns a shortest path from node from to node to in graph g, where
+// the weight of each edge is its value, as a slice of nodes that starts with
+// from and ends with to, its total weight, and true. If to is not reachable
+// from from, or if either node is not in g, it returns nil, the zero value and
+// false.
+//
+// It is like DijkstraPath, but it uses heuristic to search towards to first.
+// heuristic returns an estimate of the total weight of a shortest path from
+// the given node to to, like the straight-line distance on a map. It must be
+// admissible, which means that it never overestimates, or the returned path
+// may not be a shortest one. The closer heuristic is to the real total
+// weights, the fewer nodes are searched; a heuristic that always returns zero
+// makes AStar search as many nodes as DijkstraPath.
+//
+// AStar panics if it finds an edge with a negative weight.
+func AStar[N comparable, W Weight](
+	g GraphView[N, W],
+	from N,
+	to N,
+	heuristic func(node N) W,
+) ([]N, W, bool) {
+	return AStarFunc(g, from, to, identity[W], heuristic)
+}
+
+// AStarFunc is like AStar, but the weight of each edge is the result of
+// calling weight with its value, so it works with any type of edge value.
+func AStarFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	from N,
+	to N,
+	weight func(value V) W,
+	heuristic func(node N) W,
+) ([]N, W, bool) {
+	if !g.Nodes().Contains(from) || !g.Nodes().Contains(to) {
+		return nil, 0, false
+	}
+
+	tree := newShortestPathTree[N, W](from)
+	if !aStar(g, tree, edgeWeightFunc(g, weight), to, heuristic) {
+		return nil, 0, false
+	}
+	path, _ := tree.PathTo(to)
+	distance, _ := tree.Distance(to)
+	return path, distance, true
+}
+
+// aStar fills in tree with the shortest paths from its root that it finds on
+// the way to to, and returns true if it reaches to.
+func aStar[N comparable, W Weight](
+	g graph.GraphView[N],
+	tree *ShortestPathTree[N, W],
+	edgeWeight func(source N, target N) W,
+	to N,
+	heuristic func(node N) W,
+) bool {
+	tree.nodeToDistance[tree.root] = 0
+	queue := &priorityQueue[N, W]{}
+	heap.Push(
+		queue,
+		prioritizedNode[N, W]{node: tree.root, priority: heuristic(tree.root)},
+	)
+	for queue.Len() > 0 {
+		entry := heap.Pop(queue).(prioritizedNode[N, W])
+		node := entry.node
+		distance := tree.nodeToDistance[node]
+		if entry.priority > distance+heuristic(node) {
+			// This is an outdated entry for a node that was already reached
+			// by a shorter path.
+			continue
+		}
+		if node == to {
+			return true
+		}
+
+		// Unlike in dijkstra, nodes are not marked as settled, because with
+		// a heuristic that is admissible but not consistent, a shorter path
+		// to a node may be found after the node is popped.
+		for next := range g.Successors(node).All() {
+			nextWeight := edgeWeight(node, next)
+			if nextWeight < 0 {
+				panic(fmt.Sprintf(
+					"edge %v has a negative weight %v",
+					graph.EndpointPairOf(node, next),
+					nextWeight,
+				))
+			}
+
+			nextDistance := distance + nextWeight
+			if current, ok := tree.nodeToDistance[next]; ok && current <= nextDistance {
+				continue
+			}
+			tree.nodeToDistance[next] = nextDistance
+			tree.nodeToPredecessor[next] = node
+			heap.Push(
+				queue,
+				prioritizedNode[N, W]{
+					node:     next,
+					priority: nextDistance + heuristic(next),
+				},
+			)
+		}
+	}
+	return false
+}
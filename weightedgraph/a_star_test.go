@@ -0,0 +1,288 @@
+package weightedgraph_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestAStar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("matches Dijkstra on random grids", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(15, 16))
+		for range 10 {
+			g := randomGrid(r, 15, 15)
+			from := cell{row: r.IntN(15), column: r.IntN(15)}
+			to := cell{row: r.IntN(15), column: r.IntN(15)}
+
+			path, got, ok := weightedgraph.AStar(g, from, to, manhattanDistanceTo(to))
+
+			_, want, wantOK := weightedgraph.DijkstraPath(g, from, to)
+			if ok != wantOK || got != want {
+				t.Fatalf(
+					"weightedgraph.AStar(%v, %v): got (%v, %d, %t), want distance (%d, %t)",
+					from,
+					to,
+					path,
+					got,
+					ok,
+					want,
+					wantOK,
+				)
+			}
+			assertPathWeight(t, g, path, from, to, want)
+		}
+	})
+
+	t.Run("matches Dijkstra with a heuristic of zero", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(17, 18))
+		g := randomWeightedGraph(r, 30, 100, 0, 20)
+		for to := range 30 {
+			_, got, ok := weightedgraph.AStar(g, 0, to, func(int) int { return 0 })
+
+			_, want, wantOK := weightedgraph.DijkstraPath(g, 0, to)
+			if ok != wantOK || got != want {
+				t.Errorf(
+					"weightedgraph.AStar(0, %d): got (%d, %t), want (%d, %t)",
+					to,
+					got,
+					ok,
+					want,
+					wantOK,
+				)
+			}
+		}
+	})
+
+	t.Run("goes around walls in a grid", func(t *testing.T) {
+		t.Parallel()
+
+		// A 5x5 grid with a wall down column 2, except for a gap at the
+		// bottom.
+		g := weightedgraph.Undirected[cell, int]().Build()
+		for row := range 5 {
+			for column := range 5 {
+				here := cell{row: row, column: column}
+				g.AddNode(here)
+				if right := (cell{row: row, column: column + 1}); column < 4 &&
+					!isWall(here) && !isWall(right) {
+					g.PutEdgeValue(here, right, 1)
+				}
+				if down := (cell{row: row + 1, column: column}); row < 4 &&
+					!isWall(here) && !isWall(down) {
+					g.PutEdgeValue(here, down, 1)
+				}
+			}
+		}
+		from, to := cell{row: 0, column: 0}, cell{row: 0, column: 4}
+
+		path, distance, ok := weightedgraph.AStar(g, from, to, manhattanDistanceTo(to))
+
+		if !ok || distance != 12 {
+			t.Fatalf(
+				"weightedgraph.AStar: got (%v, %d, %t), want distance (12, true)",
+				path,
+				distance,
+				ok,
+			)
+		}
+		assertPathWeight(t, g, path, from, to, 12)
+		if !slices.Contains(path, cell{row: 4, column: 2}) {
+			t.Errorf("weightedgraph.AStar: got path %v, want it to go through the gap", path)
+		}
+	})
+
+	t.Run("finds a shorter path to an expanded node", func(t *testing.T) {
+		t.Parallel()
+
+		// The heuristic is admissible but not consistent, so a is expanded
+		// through s -> a before the shorter path s -> b -> a is found.
+		g := weightedgraph.Directed[string, int]().Build()
+		g.PutEdgeValue("s", "a", 3)
+		g.PutEdgeValue("s", "b", 1)
+		g.PutEdgeValue("b", "a", 1)
+		g.PutEdgeValue("a", "g", 4)
+		heuristic := func(node string) int {
+			if node == "b" {
+				return 3
+			}
+			return 0
+		}
+
+		path, distance, ok := weightedgraph.AStar(g, "s", "g", heuristic)
+
+		want := []string{"s", "b", "a", "g"}
+		if !ok || distance != 6 || !slices.Equal(path, want) {
+			t.Errorf(
+				"weightedgraph.AStar: got (%v, %d, %t), want (%v, 6, true)",
+				path,
+				distance,
+				ok,
+				want,
+			)
+		}
+	})
+
+	t.Run("to an unreachable node", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+		g.PutEdgeValue(3, 1, 1)
+
+		if path, distance, ok := weightedgraph.AStar(g, 1, 3, func(int) int { return 0 }); ok {
+			t.Errorf(
+				"weightedgraph.AStar: got (%v, %d, %t), want ([], 0, false)",
+				path,
+				distance,
+				ok,
+			)
+		}
+	})
+
+	t.Run("to an absent node", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+
+		if path, distance, ok := weightedgraph.AStar(g, 1, nodeNotInGraph, func(int) int { return 0 }); ok {
+			t.Errorf(
+				"weightedgraph.AStar: got (%v, %d, %t), want ([], 0, false)",
+				path,
+				distance,
+				ok,
+			)
+		}
+	})
+
+	t.Run("panics on a negative weight", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, -1)
+
+		defer func() { _ = recover() }()
+		weightedgraph.AStar(g, 1, 2, func(int) int { return 0 })
+		t.Errorf("weightedgraph.AStar: should have panicked")
+	})
+}
+
+func TestAStarFunc(t *testing.T) {
+	t.Parallel()
+
+	type road struct {
+		kilometers float64
+	}
+	g := weightedgraph.Undirected[string, road]().Build()
+	g.PutEdgeValue("a", "b", road{kilometers: 2})
+	g.PutEdgeValue("b", "c", road{kilometers: 2})
+	g.PutEdgeValue("a", "c", road{kilometers: 5})
+
+	path, distance, ok := weightedgraph.AStarFunc(
+		g,
+		"c",
+		"a",
+		func(r road) float64 { return r.kilometers },
+		func(string) float64 { return 0 },
+	)
+
+	want := []string{"c", "b", "a"}
+	if !ok || distance != 4 || !slices.Equal(path, want) {
+		t.Errorf(
+			"weightedgraph.AStarFunc: got (%v, %v, %t), want (%v, 4, true)",
+			path,
+			distance,
+			ok,
+			want,
+		)
+	}
+}
+
+type cell struct {
+	row    int
+	column int
+}
+
+func isWall(c cell) bool {
+	return c.column == 2 && c.row < 4
+}
+
+func manhattanDistanceTo(to cell) func(c cell) int {
+	return func(c cell) int {
+		return abs(c.row-to.row) + abs(c.column-to.column)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// randomGrid returns an undirected graph of the cells in a grid with the given
+// number of rows and columns, with edges between neighboring cells with random
+// weights between 1 and 10, and about a fifth of the edges left out.
+func randomGrid(
+	r *rand.Rand,
+	rows int,
+	columns int,
+) *weightedgraph.WeightedGraph[cell, int] {
+	g := weightedgraph.Undirected[cell, int]().Build()
+	for row := range rows {
+		for column := range columns {
+			here := cell{row: row, column: column}
+			g.AddNode(here)
+			if column+1 < columns && r.IntN(5) != 0 {
+				g.PutEdgeValue(here, cell{row: row, column: column + 1}, 1+r.IntN(10))
+			}
+			if row+1 < rows && r.IntN(5) != 0 {
+				g.PutEdgeValue(here, cell{row: row + 1, column: column}, 1+r.IntN(10))
+			}
+		}
+	}
+	return g
+}
+
+// assertPathWeight checks that path goes from node from to node to along edges
+// of g with a total weight of want.
+func assertPathWeight[N comparable](
+	t *testing.T,
+	g *weightedgraph.WeightedGraph[N, int],
+	path []N,
+	from N,
+	to N,
+	want int,
+) {
+	t.Helper()
+
+	if len(path) == 0 {
+		return
+	}
+	total := 0
+	for i := 1; i < len(path); i++ {
+		weight, ok := g.EdgeValue(path[i-1], path[i])
+		if !ok {
+			t.Fatalf("path %v has no edge from %v to %v", path, path[i-1], path[i])
+		}
+		total += weight
+	}
+	if path[0] != from || path[len(path)-1] != to || total != want {
+		t.Errorf(
+			"got path %v with total weight %d, want a path from %v to %v with total weight %d",
+			path,
+			total,
+			from,
+			to,
+			want,
+		)
+	}
+}
@@ -0,0 +1,94 @@
+package weightedgraph
+
+import "slices"
+
+// AllPairsShortestPaths holds the shortest paths between every pair of nodes
+// in a graph, as found by a function like FloydWarshall. Like a routing table,
+// it has the distance from each node to each node that is reachable from it,
+// which is the total weight of a shortest path between them, and the next hop,
+// which is the node after the first one on that path.
+type AllPairsShortestPaths[N comparable, W Weight] struct {
+	nodes       []N
+	nodeToIndex map[N]int
+	// distances[i][j] is the distance from nodes[i] to nodes[j] if
+	// nextHops[i][j] is not -1.
+	distances [][]W
+	// nextHops[i][j] is the index of the next hop from nodes[i] to nodes[j],
+	// or -1 if nodes[j] is not reachable from nodes[i]. nextHops[i][i] is i.
+	nextHops [][]int
+}
+
+func newAllPairsShortestPaths[N comparable, W Weight](
+	nodes []N,
+) *AllPairsShortestPaths[N, W] {
+	result := &AllPairsShortestPaths[N, W]{
+		nodes:       nodes,
+		nodeToIndex: make(map[N]int, len(nodes)),
+		distances:   make([][]W, len(nodes)),
+		nextHops:    make([][]int, len(nodes)),
+	}
+	for i, node := range nodes {
+		result.nodeToIndex[node] = i
+		result.distances[i] = make([]W, len(nodes))
+		result.nextHops[i] = make([]int, len(nodes))
+		for j := range nodes {
+			result.nextHops[i][j] = -1
+		}
+		result.nextHops[i][i] = i
+	}
+	return result
+}
+
+// Distance returns the total weight of a shortest path from node from to node
+// to and true, or the zero value and false if to is not reachable from from.
+// The distance from a node to itself is zero.
+func (a *AllPairsShortestPaths[N, W]) Distance(from N, to N) (W, bool) {
+	i, j, ok := a.indicesOf(from, to)
+	if !ok {
+		return 0, false
+	}
+	return a.distances[i][j], true
+}
+
+// NextHop returns the node after node from on a shortest path from from to
+// node to and true, or the zero value and false if to is not reachable from
+// from or if to is from itself.
+func (a *AllPairsShortestPaths[N, W]) NextHop(from N, to N) (N, bool) {
+	i, j, ok := a.indicesOf(from, to)
+	if !ok || i == j {
+		var zero N
+		return zero, false
+	}
+	return a.nodes[a.nextHops[i][j]], true
+}
+
+// Path returns a shortest path from node from to node to, as a slice of nodes
+// that starts with from and ends with to, and true. If to is not reachable
+// from from, it returns nil and false.
+func (a *AllPairsShortestPaths[N, W]) Path(from N, to N) ([]N, bool) {
+	i, j, ok := a.indicesOf(from, to)
+	if !ok {
+		return nil, false
+	}
+
+	path := []N{from}
+	for i != j {
+		i = a.nextHops[i][j]
+		path = append(path, a.nodes[i])
+	}
+	return slices.Clip(path), true
+}
+
+// indicesOf returns the indices of from and to and true, or false if either
+// node is absent or to is not reachable from from.
+func (a *AllPairsShortestPaths[N, W]) indicesOf(from N, to N) (int, int, bool) {
+	i, ok := a.nodeToIndex[from]
+	if !ok {
+		return 0, 0, false
+	}
+	j, ok := a.nodeToIndex[to]
+	if !ok || a.nextHops[i][j] == -1 {
+		return 0, 0, false
+	}
+	return i, j, true
+}
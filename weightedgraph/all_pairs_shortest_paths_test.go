@@ -0,0 +1,417 @@
+package weightedgraph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+var allPairsShortestPathsFuncs = []struct {
+	name string
+	find func(
+		g weightedgraph.GraphView[int, int],
+	) (*weightedgraph.AllPairsShortestPaths[int, int], error)
+}{
+	{
+		name: "FloydWarshall",
+		find: weightedgraph.FloydWarshall[int, int],
+	},
+	{
+		name: "Johnson",
+		find: weightedgraph.Johnson[int, int],
+	},
+}
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range allPairsShortestPathsFuncs {
+		t.Run(f.name, func(t *testing.T) {
+			t.Parallel()
+
+			testAllPairsShortestPaths(t, f.name, f.find)
+		})
+	}
+}
+
+func testAllPairsShortestPaths(
+	t *testing.T,
+	name string,
+	find func(
+		g weightedgraph.GraphView[int, int],
+	) (*weightedgraph.AllPairsShortestPaths[int, int], error),
+) {
+	t.Run("finds the distances in a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		paths, err := find(allPairsTextbookGraph())
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		want := [][]int{
+			{0, 1, -3, 2, -4},
+			{3, 0, -4, 1, -1},
+			{7, 4, 0, 5, 3},
+			{2, -1, -5, 0, -2},
+			{8, 5, 1, 6, 0},
+		}
+		for from := 1; from <= 5; from++ {
+			for to := 1; to <= 5; to++ {
+				got, ok := paths.Distance(from, to)
+				if wantDistance := want[from-1][to-1]; !ok || got != wantDistance {
+					t.Errorf(
+						"AllPairsShortestPaths.Distance(%d, %d): got (%d, %t), want (%d, true)",
+						from,
+						to,
+						got,
+						ok,
+						wantDistance,
+					)
+				}
+			}
+		}
+	})
+
+	t.Run("finds the paths in a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		paths, err := find(allPairsTextbookGraph())
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		want := []int{1, 5, 4, 3, 2}
+		if got, ok := paths.Path(1, 2); !ok || !slices.Equal(got, want) {
+			t.Errorf(
+				"AllPairsShortestPaths.Path: got (%v, %t), want (%v, true)",
+				got,
+				ok,
+				want,
+			)
+		}
+		if got, ok := paths.NextHop(1, 2); !ok || got != 5 {
+			t.Errorf("AllPairsShortestPaths.NextHop: got (%d, %t), want (5, true)", got, ok)
+		}
+		if got, ok := paths.NextHop(1, 1); ok {
+			t.Errorf("AllPairsShortestPaths.NextHop: got (%d, %t), want (0, false)", got, ok)
+		}
+		if got, ok := paths.Path(3, 3); !ok || !slices.Equal(got, []int{3}) {
+			t.Errorf("AllPairsShortestPaths.Path: got (%v, %t), want ([3], true)", got, ok)
+		}
+	})
+
+	t.Run("leaves out unreachable and absent nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+		g.AddNode(3)
+
+		paths, err := find(g)
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		for _, pair := range []struct{ from, to int }{
+			{2, 1},
+			{1, 3},
+			{1, nodeNotInGraph},
+			{nodeNotInGraph, 1},
+		} {
+			if got, ok := paths.Distance(pair.from, pair.to); ok {
+				t.Errorf(
+					"AllPairsShortestPaths.Distance(%d, %d): got (%d, %t), want (0, false)",
+					pair.from,
+					pair.to,
+					got,
+					ok,
+				)
+			}
+			if got, ok := paths.NextHop(pair.from, pair.to); ok {
+				t.Errorf(
+					"AllPairsShortestPaths.NextHop(%d, %d): got (%d, %t), want (0, false)",
+					pair.from,
+					pair.to,
+					got,
+					ok,
+				)
+			}
+			if got, ok := paths.Path(pair.from, pair.to); ok {
+				t.Errorf(
+					"AllPairsShortestPaths.Path(%d, %d): got (%v, %t), want ([], false)",
+					pair.from,
+					pair.to,
+					got,
+					ok,
+				)
+			}
+		}
+	})
+
+	t.Run("ignores a self-loop with a positive weight", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().AllowsSelfLoops(true).Build()
+		g.PutEdgeValue(1, 1, 5)
+
+		paths, err := find(g)
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		if got, ok := paths.Distance(1, 1); !ok || got != 0 {
+			t.Errorf("AllPairsShortestPaths.Distance: got (%d, %t), want (0, true)", got, ok)
+		}
+	})
+
+	t.Run("reports a negative cycle", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+		g.PutEdgeValue(2, 3, -3)
+		g.PutEdgeValue(3, 1, 1)
+		g.PutEdgeValue(3, 4, 1)
+		g.PutEdgeValue(5, 6, -1)
+		g.PutEdgeValue(6, 5, -1)
+		g.PutEdgeValue(7, 1, 1)
+
+		paths, err := find(g)
+
+		var cycleErr *weightedgraph.NegativeCycleError[int]
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(
+				"weightedgraph.%s: got (%v, %v), want a *NegativeCycleError",
+				name,
+				paths,
+				err,
+			)
+		}
+		want := set.Of(1, 2, 3, 4, 5, 6)
+		if got := cycleErr.NegativeInfinity; !set.Equal[int](got, want) {
+			t.Errorf(
+				"NegativeCycleError.NegativeInfinity: got %v, want %v",
+				got,
+				want,
+			)
+		}
+	})
+
+	t.Run("matches Bellman-Ford on random acyclic graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(11, 12))
+		for range 10 {
+			g := randomAcyclicWeightedGraph(r, 20, 60, -20, 20)
+
+			paths, err := find(g)
+			if err != nil {
+				t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+			}
+
+			for from := range 20 {
+				tree, err := weightedgraph.BellmanFord(g, from)
+				if err != nil {
+					t.Fatalf("weightedgraph.BellmanFord: got error %v, want nil", err)
+				}
+				for to := range 20 {
+					assertAllPairsDistance(t, g, paths, tree, from, to)
+				}
+			}
+		}
+	})
+
+	t.Run("matches Dijkstra on random undirected graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(13, 14))
+		for range 10 {
+			g := weightedgraph.Undirected[int, int]().Build()
+			for node := range 20 {
+				g.AddNode(node)
+			}
+			for range 40 {
+				source, target := r.IntN(20), r.IntN(20)
+				if source != target {
+					g.PutEdgeValue(source, target, r.IntN(21))
+				}
+			}
+
+			paths, err := find(g)
+			if err != nil {
+				t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+			}
+
+			for from := range 20 {
+				tree := weightedgraph.Dijkstra(g, from)
+				for to := range 20 {
+					assertAllPairsDistance(t, g, paths, tree, from, to)
+				}
+			}
+		}
+	})
+
+	t.Run("counts edges in a unit weighted graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := graph.Directed[int]().Build()
+		g.PutEdge(1, 2)
+		g.PutEdge(2, 3)
+		g.PutEdge(3, 4)
+		g.PutEdge(1, 4)
+		g.PutEdge(4, 5)
+
+		paths, err := find(weightedgraph.UnitWeighted[int](g))
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		for from := range g.Nodes().All() {
+			for to, want := range graph.Distances(g, from) {
+				if got, ok := paths.Distance(from, to); !ok || got != want {
+					t.Errorf(
+						"AllPairsShortestPaths.Distance(%d, %d): got (%d, %t), want (%d, true)",
+						from,
+						to,
+						got,
+						ok,
+						want,
+					)
+				}
+			}
+		}
+	})
+}
+
+func TestFloydWarshallFunc(t *testing.T) {
+	t.Parallel()
+
+	g := weightedgraph.Directed[string, float64]().Build()
+	g.PutEdgeValue("a", "b", 0.5)
+	g.PutEdgeValue("b", "c", 0.25)
+
+	paths, err := weightedgraph.FloydWarshallFunc(
+		g,
+		func(value float64) float64 { return value * 4 },
+	)
+	if err != nil {
+		t.Fatalf("weightedgraph.FloydWarshallFunc: got error %v, want nil", err)
+	}
+
+	if got, ok := paths.Distance("a", "c"); !ok || got != 3 {
+		t.Errorf("AllPairsShortestPaths.Distance: got (%v, %t), want (3, true)", got, ok)
+	}
+}
+
+func TestJohnsonFunc(t *testing.T) {
+	t.Parallel()
+
+	g := weightedgraph.Directed[string, float64]().Build()
+	g.PutEdgeValue("a", "b", 0.5)
+	g.PutEdgeValue("b", "c", -0.25)
+
+	paths, err := weightedgraph.JohnsonFunc(
+		g,
+		func(value float64) float64 { return value * 4 },
+	)
+	if err != nil {
+		t.Fatalf("weightedgraph.JohnsonFunc: got error %v, want nil", err)
+	}
+
+	if got, ok := paths.Distance("a", "c"); !ok || got != 1 {
+		t.Errorf("AllPairsShortestPaths.Distance: got (%v, %t), want (1, true)", got, ok)
+	}
+}
+
+// assertAllPairsDistance checks that paths has the same distance from node from
+// to node to as tree, and that its path between them has that total weight.
+func assertAllPairsDistance(
+	t *testing.T,
+	g *weightedgraph.WeightedGraph[int, int],
+	paths *weightedgraph.AllPairsShortestPaths[int, int],
+	tree *weightedgraph.ShortestPathTree[int, int],
+	from int,
+	to int,
+) {
+	t.Helper()
+
+	want, wantOK := tree.Distance(to)
+	got, ok := paths.Distance(from, to)
+	if ok != wantOK || got != want {
+		t.Errorf(
+			"AllPairsShortestPaths.Distance(%d, %d): got (%d, %t), want (%d, %t)",
+			from,
+			to,
+			got,
+			ok,
+			want,
+			wantOK,
+		)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	path, _ := paths.Path(from, to)
+	total := 0
+	for i := 1; i < len(path); i++ {
+		weight, _ := g.EdgeValue(path[i-1], path[i])
+		total += weight
+	}
+	if path[0] != from || path[len(path)-1] != to || total != want {
+		t.Errorf(
+			"AllPairsShortestPaths.Path(%d, %d): got %v with total weight %d, want total weight %d",
+			from,
+			to,
+			path,
+			total,
+			want,
+		)
+	}
+}
+
+// allPairsTextbookGraph returns the directed graph that is used to demonstrate
+// Johnson's algorithm in "Introduction to Algorithms" by Cormen et al.
+func allPairsTextbookGraph() *weightedgraph.WeightedGraph[int, int] {
+	g := weightedgraph.Directed[int, int]().Build()
+	g.PutEdgeValue(1, 2, 3)
+	g.PutEdgeValue(1, 3, 8)
+	g.PutEdgeValue(1, 5, -4)
+	g.PutEdgeValue(2, 4, 1)
+	g.PutEdgeValue(2, 5, 7)
+	g.PutEdgeValue(3, 2, 4)
+	g.PutEdgeValue(4, 1, 2)
+	g.PutEdgeValue(4, 3, -5)
+	g.PutEdgeValue(5, 4, 6)
+	return g
+}
+
+// randomAcyclicWeightedGraph is like randomWeightedGraph, but its edges only go
+// from lower nodes to higher nodes, so it has no cycles, negative or
+// otherwise.
+func randomAcyclicWeightedGraph(
+	r *rand.Rand,
+	nodes int,
+	edges int,
+	minWeight int,
+	maxWeight int,
+) *weightedgraph.WeightedGraph[int, int] {
+	g := weightedgraph.Directed[int, int]().Build()
+	for node := range nodes {
+		g.AddNode(node)
+	}
+	for g.Edges().Len() < edges {
+		source, target := r.IntN(nodes), r.IntN(nodes)
+		if source < target {
+			g.PutEdgeValue(source, target, minWeight+r.IntN(maxWeight-minWeight+1))
+		}
+	}
+	return g
+}
@@ -0,0 +1,146 @@
+package weightedgraph
+
+import (
+	"slices"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+// BellmanFord returns the shortest paths from node from to every node that is
+// reachable from it in graph g, where the weight of each edge is its value.
+// Unlike Dijkstra, the weights may be negative. If from is not in g, the
+// returned tree has no nodes.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes, so an undirected edge with a negative weight is a
+// cycle with a negative total weight.
+//
+// If a cycle with a negative total weight can be reached from from,
+// BellmanFord returns a nil tree and a *NegativeCycleError holding one such
+// cycle and every node whose distance is negative infinity. It runs in
+// O(V * E) time.
+func BellmanFord[N comparable, W Weight](
+	g GraphView[N, W],
+	from N,
+) (*ShortestPathTree[N, W], error) {
+	return BellmanFordFunc(g, from, identity[W])
+}
+
+// BellmanFordFunc is like BellmanFord, but the weight of each edge is the
+// result of calling weight with its value, so it works with any type of edge
+// value.
+func BellmanFordFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	from N,
+	weight func(value V) W,
+) (*ShortestPathTree[N, W], error) {
+	tree := newShortestPathTree[N, W](from)
+	if !g.Nodes().Contains(from) {
+		return tree, nil
+	}
+
+	tree.nodeToDistance[from] = 0
+	// With no negative cycles, every shortest path has at most V - 1 edges, so
+	// V - 1 rounds of relaxing every edge are enough.
+	if err := bellmanFord(g, tree, edgeWeightFunc(g, weight), g.Nodes().Len()-1); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// bellmanFord lowers the distances in tree, starting from the nodes that
+// already have one, by relaxing every edge for the given number of rounds. If
+// any distance can still be lowered after that, it returns a
+// *NegativeCycleError.
+func bellmanFord[N comparable, W Weight](
+	g graph.GraphView[N],
+	tree *ShortestPathTree[N, W],
+	edgeWeight func(source N, target N) W,
+	rounds int,
+) error {
+	for range rounds {
+		if len(relaxEdges(g, tree, edgeWeight)) == 0 {
+			return nil
+		}
+	}
+
+	// Any node that can still be relaxed is reachable from a negative cycle,
+	// and every negative cycle that is reachable from the starting nodes has
+	// such a node.
+	relaxed := relaxEdges(g, tree, edgeWeight)
+	if len(relaxed) == 0 {
+		return nil
+	}
+	return &NegativeCycleError[N]{
+		Cycle:            negativeCycle(tree, relaxed[0], rounds+1),
+		NegativeInfinity: set.Unmodifiable[N](reachableFrom(g, relaxed)),
+	}
+}
+
+// relaxEdges does one round of relaxing every edge whose source has a distance
+// in tree, and returns the nodes whose distances were lowered.
+func relaxEdges[N comparable, W Weight](
+	g graph.GraphView[N],
+	tree *ShortestPathTree[N, W],
+	edgeWeight func(source N, target N) W,
+) []N {
+	var result []N
+	for node := range g.Nodes().All() {
+		distance, ok := tree.nodeToDistance[node]
+		if !ok {
+			continue
+		}
+		for next := range g.Successors(node).All() {
+			nextDistance := distance + edgeWeight(node, next)
+			if current, ok := tree.nodeToDistance[next]; ok && current <= nextDistance {
+				continue
+			}
+			tree.nodeToDistance[next] = nextDistance
+			tree.nodeToPredecessor[next] = node
+			result = append(result, next)
+		}
+	}
+	return result
+}
+
+// negativeCycle returns the negative cycle that is found by following the
+// predecessors in tree back from node, which must have been relaxed after
+// steps - 1 rounds of relaxation.
+func negativeCycle[N comparable, W Weight](
+	tree *ShortestPathTree[N, W],
+	node N,
+	steps int,
+) []N {
+	// Going back this many times from node is sure to end on the cycle, since
+	// no shortest path outside a cycle can be that long.
+	for range steps {
+		node = tree.nodeToPredecessor[node]
+	}
+
+	result := []N{node}
+	for predecessor := tree.nodeToPredecessor[node]; predecessor != node; {
+		result = append(result, predecessor)
+		predecessor = tree.nodeToPredecessor[predecessor]
+	}
+	slices.Reverse(result)
+	return result
+}
+
+func reachableFrom[N comparable](g graph.GraphView[N], nodes []N) set.Set[N] {
+	result := set.Of[N]()
+	stack := slices.Clone(nodes)
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !result.Add(node) {
+			continue
+		}
+		for next := range g.Successors(node).All() {
+			if !result.Contains(next) {
+				stack = append(stack, next)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,286 @@
+package weightedgraph_test
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/set"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestBellmanFord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finds the shortest paths in a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		tree, err := weightedgraph.BellmanFord(negativeWeightTextbookGraph(), "s")
+		if err != nil {
+			t.Fatalf("weightedgraph.BellmanFord: got error %v, want nil", err)
+		}
+
+		wantDistances := map[string]int{"s": 0, "t": 2, "x": 4, "y": 7, "z": -2}
+		if got := tree.Distances(); !maps.Equal(got, wantDistances) {
+			t.Errorf(
+				"weightedgraph.BellmanFord: got distances %v, want %v",
+				got,
+				wantDistances,
+			)
+		}
+		wantPath := []string{"s", "y", "x", "t", "z"}
+		if got, ok := tree.PathTo("z"); !ok || !slices.Equal(got, wantPath) {
+			t.Errorf(
+				"ShortestPathTree.PathTo: got (%v, %t), want (%v, true)",
+				got,
+				ok,
+				wantPath,
+			)
+		}
+	})
+
+	t.Run("from an absent node has no nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tree, err := weightedgraph.BellmanFord(negativeWeightTextbookGraph(), "absent")
+
+		if err != nil || len(tree.Distances()) != 0 {
+			t.Errorf(
+				"weightedgraph.BellmanFord: got (%v, %v), want an empty tree and nil",
+				tree.Distances(),
+				err,
+			)
+		}
+	})
+
+	t.Run("reports a reachable negative cycle", func(t *testing.T) {
+		t.Parallel()
+
+		g := negativeCycleGraph()
+
+		tree, err := weightedgraph.BellmanFord(g, "s")
+
+		var cycleErr *weightedgraph.NegativeCycleError[string]
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(
+				"weightedgraph.BellmanFord: got (%v, %v), want a *NegativeCycleError",
+				tree,
+				err,
+			)
+		}
+		assertNegativeCycle(t, g, cycleErr.Cycle, "a", "b", "c")
+		want := set.Of("a", "b", "c", "d")
+		if got := cycleErr.NegativeInfinity; !set.Equal[string](got, want) {
+			t.Errorf(
+				"NegativeCycleError.NegativeInfinity: got %v, want %v",
+				got,
+				want,
+			)
+		}
+	})
+
+	t.Run("ignores an unreachable negative cycle", func(t *testing.T) {
+		t.Parallel()
+
+		tree, err := weightedgraph.BellmanFord(negativeCycleGraph(), "e")
+		if err != nil {
+			t.Fatalf("weightedgraph.BellmanFord: got error %v, want nil", err)
+		}
+
+		want := map[string]int{"e": 0, "f": 2}
+		if got := tree.Distances(); !maps.Equal(got, want) {
+			t.Errorf("weightedgraph.BellmanFord: got distances %v, want %v", got, want)
+		}
+	})
+
+	t.Run("treats a negative undirected edge as a negative cycle", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, int]().Build()
+		g.PutEdgeValue("a", "b", 3)
+		g.PutEdgeValue("b", "c", -1)
+
+		_, err := weightedgraph.BellmanFord(g, "a")
+
+		var cycleErr *weightedgraph.NegativeCycleError[string]
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(
+				"weightedgraph.BellmanFord: got error %v, want a *NegativeCycleError",
+				err,
+			)
+		}
+		assertNegativeCycle(t, g, cycleErr.Cycle, "b", "c")
+		want := set.Of("a", "b", "c")
+		if got := cycleErr.NegativeInfinity; !set.Equal[string](got, want) {
+			t.Errorf(
+				"NegativeCycleError.NegativeInfinity: got %v, want %v",
+				got,
+				want,
+			)
+		}
+	})
+
+	t.Run("reports a negative self-loop", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, int]().AllowsSelfLoops(true).Build()
+		g.PutEdgeValue("a", "b", 1)
+		g.PutEdgeValue("b", "b", -1)
+
+		_, err := weightedgraph.BellmanFord(g, "a")
+
+		var cycleErr *weightedgraph.NegativeCycleError[string]
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf(
+				"weightedgraph.BellmanFord: got error %v, want a *NegativeCycleError",
+				err,
+			)
+		}
+		if got := cycleErr.Cycle; !slices.Equal(got, []string{"b"}) {
+			t.Errorf("NegativeCycleError.Cycle: got %v, want [b]", got)
+		}
+	})
+
+	t.Run("matches Dijkstra on random graphs without negative weights", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(7, 8))
+		for range 20 {
+			g := randomWeightedGraph(r, 30, 100, 0, 20)
+
+			tree, err := weightedgraph.BellmanFord(g, 0)
+			if err != nil {
+				t.Fatalf("weightedgraph.BellmanFord: got error %v, want nil", err)
+			}
+
+			want := weightedgraph.Dijkstra(g, 0).Distances()
+			if got := tree.Distances(); !maps.Equal(got, want) {
+				t.Errorf("weightedgraph.BellmanFord: got %v, want %v", got, want)
+			}
+		}
+	})
+
+	t.Run("matches repeated relaxation on random acyclic graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(9, 10))
+		for range 20 {
+			g := weightedgraph.Directed[int, int]().Build()
+			for range 100 {
+				// Edges only go from lower to higher nodes, so there are no
+				// cycles, negative or otherwise.
+				source, target := r.IntN(30), r.IntN(30)
+				if source < target {
+					g.PutEdgeValue(source, target, r.IntN(41)-20)
+				}
+			}
+			g.AddNode(0)
+
+			tree, err := weightedgraph.BellmanFord(g, 0)
+			if err != nil {
+				t.Fatalf("weightedgraph.BellmanFord: got error %v, want nil", err)
+			}
+
+			want := relaxedDistances(g, 0)
+			if got := tree.Distances(); !maps.Equal(got, want) {
+				t.Errorf("weightedgraph.BellmanFord: got %v, want %v", got, want)
+			}
+		}
+	})
+}
+
+func TestBellmanFordFunc(t *testing.T) {
+	t.Parallel()
+
+	type trip struct {
+		fare   float64
+		rebate float64
+	}
+	g := weightedgraph.Directed[string, trip]().Build()
+	g.PutEdgeValue("a", "b", trip{fare: 5, rebate: 7})
+	g.PutEdgeValue("b", "c", trip{fare: 3, rebate: 0})
+	g.PutEdgeValue("a", "c", trip{fare: 2, rebate: 0})
+
+	tree, err := weightedgraph.BellmanFordFunc(
+		g,
+		"a",
+		func(t trip) float64 { return t.fare - t.rebate },
+	)
+	if err != nil {
+		t.Fatalf("weightedgraph.BellmanFordFunc: got error %v, want nil", err)
+	}
+
+	if got, ok := tree.Distance("c"); !ok || got != 1 {
+		t.Errorf("ShortestPathTree.Distance: got (%v, %t), want (1, true)", got, ok)
+	}
+}
+
+// negativeWeightTextbookGraph returns the directed graph that is used to
+// demonstrate the Bellman-Ford algorithm in "Introduction to Algorithms" by
+// Cormen et al.
+func negativeWeightTextbookGraph() *weightedgraph.WeightedGraph[string, int] {
+	g := weightedgraph.Directed[string, int]().Build()
+	g.PutEdgeValue("s", "t", 6)
+	g.PutEdgeValue("s", "y", 7)
+	g.PutEdgeValue("t", "x", 5)
+	g.PutEdgeValue("t", "y", 8)
+	g.PutEdgeValue("t", "z", -4)
+	g.PutEdgeValue("x", "t", -2)
+	g.PutEdgeValue("y", "x", -3)
+	g.PutEdgeValue("y", "z", 9)
+	g.PutEdgeValue("z", "s", 2)
+	g.PutEdgeValue("z", "x", 7)
+	return g
+}
+
+// negativeCycleGraph returns a directed graph where the cycle a -> b -> c -> a
+// has a negative total weight and is reachable from s but not from e.
+func negativeCycleGraph() *weightedgraph.WeightedGraph[string, int] {
+	g := weightedgraph.Directed[string, int]().Build()
+	g.PutEdgeValue("s", "a", 1)
+	g.PutEdgeValue("a", "b", 1)
+	g.PutEdgeValue("b", "c", -3)
+	g.PutEdgeValue("c", "a", 1)
+	g.PutEdgeValue("c", "d", 1)
+	g.PutEdgeValue("s", "e", 1)
+	g.PutEdgeValue("e", "f", 2)
+	return g
+}
+
+// assertNegativeCycle checks that cycle has exactly the given nodes, in any
+// rotation, and that it is a cycle in g with a negative total weight.
+func assertNegativeCycle(
+	t *testing.T,
+	g *weightedgraph.WeightedGraph[string, int],
+	cycle []string,
+	wantNodes ...string,
+) {
+	t.Helper()
+
+	if !set.Equal[string](set.Of(cycle...), set.Of(wantNodes...)) || len(cycle) != len(wantNodes) {
+		t.Fatalf("NegativeCycleError.Cycle: got %v, want nodes %v", cycle, wantNodes)
+	}
+	total := 0
+	for i, source := range cycle {
+		target := cycle[(i+1)%len(cycle)]
+		weight, ok := g.EdgeValue(source, target)
+		if !ok {
+			t.Fatalf(
+				"NegativeCycleError.Cycle: got %v, which has no edge from %v to %v",
+				cycle,
+				source,
+				target,
+			)
+		}
+		total += weight
+	}
+	if total >= 0 {
+		t.Errorf(
+			"NegativeCycleError.Cycle: got %v with total weight %d, want a negative total weight",
+			cycle,
+			total,
+		)
+	}
+}
@@ -0,0 +1,184 @@
+package weightedgraph
+
+import (
+	"container/heap"
+	"fmt"
+	"iter"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+// Dijkstra returns the shortest paths from node from to every node that is
+// reachable from it in graph g, where the weight of each edge is its value.
+// If from is not in g, the returned tree has no nodes.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes.
+//
+// Dijkstra panics if it finds an edge with a negative weight; see BellmanFord
+// for graphs with negative weights. It runs in O((V + E) log V) time.
+func Dijkstra[N comparable, W Weight](
+	g GraphView[N, W],
+	from N,
+) *ShortestPathTree[N, W] {
+	return DijkstraFunc(g, from, identity[W])
+}
+
+// DijkstraFunc is like Dijkstra, but the weight of each edge is the result of
+// calling weight with its value, so it works with any type of edge value.
+func DijkstraFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	from N,
+	weight func(value V) W,
+) *ShortestPathTree[N, W] {
+	tree := newShortestPathTree[N, W](from)
+	if g.Nodes().Contains(from) {
+		dijkstra(successorsFunc(g), tree, edgeWeightFunc(g, weight), nil)
+	}
+	return tree
+}
+
+// DijkstraPath returns a shortest path from node from to node to in graph g,
+// as a slice of nodes that starts with from and ends with to, its total
+// weight, and true. If to is not reachable from from, or if either node is
+// not in g, it returns nil, the zero value and false.
+//
+// It is like Dijkstra, but it stops searching as soon as it has found a
+// shortest path to to.
+func DijkstraPath[N comparable, W Weight](
+	g GraphView[N, W],
+	from N,
+	to N,
+) ([]N, W, bool) {
+	return DijkstraPathFunc(g, from, to, identity[W])
+}
+
+// DijkstraPathFunc is like DijkstraPath, but the weight of each edge is the
+// result of calling weight with its value, so it works with any type of edge
+// value.
+func DijkstraPathFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	from N,
+	to N,
+	weight func(value V) W,
+) ([]N, W, bool) {
+	if !g.Nodes().Contains(from) || !g.Nodes().Contains(to) {
+		return nil, 0, false
+	}
+
+	tree := newShortestPathTree[N, W](from)
+	dijkstra(successorsFunc(g), tree, edgeWeightFunc(g, weight), &to)
+	path, ok := tree.PathTo(to)
+	if !ok {
+		return nil, 0, false
+	}
+	distance, _ := tree.Distance(to)
+	return path, distance, true
+}
+
+// dijkstra fills in tree with the shortest paths from its root, following the
+// nodes returned by successors. If to is not nil, it stops as soon as the
+// shortest path to *to is known, so the tree may be missing some of the
+// reachable nodes.
+func dijkstra[N comparable, W Weight](
+	successors func(node N) iter.Seq[N],
+	tree *ShortestPathTree[N, W],
+	edgeWeight func(source N, target N) W,
+	to *N,
+) {
+	tree.nodeToDistance[tree.root] = 0
+	settled := make(map[N]bool)
+	queue := &priorityQueue[N, W]{}
+	heap.Push(queue, prioritizedNode[N, W]{node: tree.root, priority: 0})
+	for queue.Len() > 0 {
+		node := heap.Pop(queue).(prioritizedNode[N, W]).node
+		if settled[node] {
+			// This is an outdated entry for a node that was already reached
+			// by a shorter path.
+			continue
+		}
+		settled[node] = true
+		if to != nil && node == *to {
+			return
+		}
+
+		distance := tree.nodeToDistance[node]
+		for next := range successors(node) {
+			if settled[next] {
+				continue
+			}
+			nextWeight := edgeWeight(node, next)
+			if nextWeight < 0 {
+				panic(fmt.Sprintf(
+					"edge %v has a negative weight %v",
+					graph.EndpointPairOf(node, next),
+					nextWeight,
+				))
+			}
+
+			nextDistance := distance + nextWeight
+			if current, ok := tree.nodeToDistance[next]; ok && current <= nextDistance {
+				continue
+			}
+			tree.nodeToDistance[next] = nextDistance
+			tree.nodeToPredecessor[next] = node
+			heap.Push(
+				queue,
+				prioritizedNode[N, W]{node: next, priority: nextDistance},
+			)
+		}
+	}
+}
+
+// successorsFunc returns a function that returns the successors of a node in
+// g.
+func successorsFunc[N comparable](g graph.GraphView[N]) func(node N) iter.Seq[N] {
+	return func(node N) iter.Seq[N] {
+		return g.Successors(node).All()
+	}
+}
+
+// edgeWeightFunc returns a function that returns the weight of the edge from
+// source to target in g, which is the result of calling weight with its value.
+func edgeWeightFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	weight func(value V) W,
+) func(source N, target N) W {
+	return func(source N, target N) W {
+		value, _ := g.EdgeValue(source, target)
+		return weight(value)
+	}
+}
+
+type prioritizedNode[N comparable, W Weight] struct {
+	node     N
+	priority W
+}
+
+// priorityQueue is a binary min-heap of nodes ordered by priority, for use with
+// container/heap.
+type priorityQueue[N comparable, W Weight] []prioritizedNode[N, W]
+
+func (q priorityQueue[N, W]) Len() int {
+	return len(q)
+}
+
+func (q priorityQueue[N, W]) Less(i, j int) bool {
+	return q[i].priority < q[j].priority
+}
+
+func (q priorityQueue[N, W]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *priorityQueue[N, W]) Push(x any) {
+	*q = append(*q, x.(prioritizedNode[N, W]))
+}
+
+func (q *priorityQueue[N, W]) Pop() any {
+	old := *q
+	n := len(old)
+	result := old[n-1]
+	*q = old[:n-1]
+	return result
+}
@@ -0,0 +1,342 @@
+package weightedgraph_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestDijkstra(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finds the distances in a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		tree := weightedgraph.Dijkstra(textbookGraph(), "s")
+
+		want := map[string]int{"s": 0, "t": 8, "x": 9, "y": 5, "z": 7}
+		if got := tree.Distances(); !maps.Equal(got, want) {
+			t.Errorf("weightedgraph.Dijkstra: got distances %v, want %v", got, want)
+		}
+		if got := tree.Root(); got != "s" {
+			t.Errorf("ShortestPathTree.Root: got %q, want \"s\"", got)
+		}
+	})
+
+	t.Run("finds the paths in a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		tree := weightedgraph.Dijkstra(textbookGraph(), "s")
+
+		for node, want := range map[string][]string{
+			"s": {"s"},
+			"t": {"s", "y", "t"},
+			"x": {"s", "y", "t", "x"},
+			"y": {"s", "y"},
+			"z": {"s", "y", "z"},
+		} {
+			if got, ok := tree.PathTo(node); !ok || !slices.Equal(got, want) {
+				t.Errorf(
+					"ShortestPathTree.PathTo(%q): got (%v, %t), want (%v, true)",
+					node,
+					got,
+					ok,
+					want,
+				)
+			}
+		}
+		if got, ok := tree.Predecessor("x"); !ok || got != "t" {
+			t.Errorf(
+				"ShortestPathTree.Predecessor: got (%q, %t), want (\"t\", true)",
+				got,
+				ok,
+			)
+		}
+		if got, ok := tree.Predecessor("s"); ok {
+			t.Errorf(
+				"ShortestPathTree.Predecessor: got (%q, %t), want (\"\", false)",
+				got,
+				ok,
+			)
+		}
+	})
+
+	t.Run("follows undirected edges both ways", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+		g.PutEdgeValue(3, 2, 1)
+		g.PutEdgeValue(1, 3, 5)
+
+		if got, ok := weightedgraph.Dijkstra(g, 3).Distance(1); !ok || got != 2 {
+			t.Errorf("ShortestPathTree.Distance: got (%d, %t), want (2, true)", got, ok)
+		}
+	})
+
+	t.Run("leaves out unreachable nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+		g.PutEdgeValue(3, 1, 1)
+
+		tree := weightedgraph.Dijkstra(g, 1)
+
+		if got, ok := tree.Distance(3); ok {
+			t.Errorf("ShortestPathTree.Distance: got (%d, %t), want (0, false)", got, ok)
+		}
+		if got, ok := tree.PathTo(3); ok {
+			t.Errorf("ShortestPathTree.PathTo: got (%v, %t), want ([], false)", got, ok)
+		}
+	})
+
+	t.Run("from an absent node has no nodes", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+
+		tree := weightedgraph.Dijkstra(g, nodeNotInGraph)
+
+		if got := tree.Distances(); len(got) != 0 {
+			t.Errorf("ShortestPathTree.Distances: got %v, want an empty map", got)
+		}
+	})
+
+	t.Run("allows zero weights", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, 0)
+		g.PutEdgeValue(2, 3, 0)
+
+		if got, ok := weightedgraph.Dijkstra(g, 1).Distance(3); !ok || got != 0 {
+			t.Errorf("ShortestPathTree.Distance: got (%d, %t), want (0, true)", got, ok)
+		}
+	})
+
+	t.Run("panics on a negative weight", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[int, int]().Build()
+		g.PutEdgeValue(1, 2, -1)
+
+		defer func() { _ = recover() }()
+		weightedgraph.Dijkstra(g, 1)
+		t.Errorf("weightedgraph.Dijkstra: should have panicked")
+	})
+
+	t.Run("matches unweighted distances when all weights are 1", func(t *testing.T) {
+		t.Parallel()
+
+		g := randomWeightedGraph(rand.New(rand.NewPCG(1, 2)), 50, 200, 1, 1)
+
+		got := weightedgraph.Dijkstra(g, 0).Distances()
+
+		want := graph.Distances(g, 0)
+		if !maps.Equal(got, want) {
+			t.Errorf("weightedgraph.Dijkstra: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("matches repeated relaxation on random graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(3, 4))
+		for range 20 {
+			g := randomWeightedGraph(r, 30, 100, 0, 20)
+
+			got := weightedgraph.Dijkstra(g, 0).Distances()
+
+			if want := relaxedDistances(g, 0); !maps.Equal(got, want) {
+				t.Errorf("weightedgraph.Dijkstra: got %v, want %v", got, want)
+			}
+		}
+	})
+}
+
+func TestDijkstraFunc(t *testing.T) {
+	t.Parallel()
+
+	type link struct {
+		latencyMillis float64
+		name          string
+	}
+	g := weightedgraph.Directed[string, link]().Build()
+	g.PutEdgeValue("a", "b", link{latencyMillis: 1.5, name: "fast"})
+	g.PutEdgeValue("b", "c", link{latencyMillis: 1.5, name: "fast"})
+	g.PutEdgeValue("a", "c", link{latencyMillis: 4, name: "slow"})
+
+	tree := weightedgraph.DijkstraFunc(
+		g,
+		"a",
+		func(l link) float64 { return l.latencyMillis },
+	)
+
+	if got, ok := tree.Distance("c"); !ok || got != 3 {
+		t.Errorf("ShortestPathTree.Distance: got (%v, %t), want (3, true)", got, ok)
+	}
+}
+
+func TestDijkstraPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finds a shortest path in a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		path, distance, ok := weightedgraph.DijkstraPath(textbookGraph(), "s", "x")
+
+		want := []string{"s", "y", "t", "x"}
+		if !ok || distance != 9 || !slices.Equal(path, want) {
+			t.Errorf(
+				"weightedgraph.DijkstraPath: got (%v, %d, %t), want (%v, 9, true)",
+				path,
+				distance,
+				ok,
+				want,
+			)
+		}
+	})
+
+	t.Run("from a node to itself", func(t *testing.T) {
+		t.Parallel()
+
+		path, distance, ok := weightedgraph.DijkstraPath(textbookGraph(), "z", "z")
+
+		if !ok || distance != 0 || !slices.Equal(path, []string{"z"}) {
+			t.Errorf(
+				"weightedgraph.DijkstraPath: got (%v, %d, %t), want ([z], 0, true)",
+				path,
+				distance,
+				ok,
+			)
+		}
+	})
+
+	t.Run("to an unreachable node", func(t *testing.T) {
+		t.Parallel()
+
+		g := textbookGraph()
+		g.AddNode("unreachable")
+
+		if path, distance, ok := weightedgraph.DijkstraPath(g, "s", "unreachable"); ok {
+			t.Errorf(
+				"weightedgraph.DijkstraPath: got (%v, %d, %t), want ([], 0, false)",
+				path,
+				distance,
+				ok,
+			)
+		}
+	})
+
+	t.Run("to an absent node", func(t *testing.T) {
+		t.Parallel()
+
+		if path, distance, ok := weightedgraph.DijkstraPath(textbookGraph(), "s", "absent"); ok {
+			t.Errorf(
+				"weightedgraph.DijkstraPath: got (%v, %d, %t), want ([], 0, false)",
+				path,
+				distance,
+				ok,
+			)
+		}
+	})
+
+	t.Run("matches Dijkstra on random graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(5, 6))
+		for range 20 {
+			g := randomWeightedGraph(r, 30, 100, 0, 20)
+			tree := weightedgraph.Dijkstra(g, 0)
+
+			for to := range 30 {
+				want, wantOK := tree.Distance(to)
+				_, got, ok := weightedgraph.DijkstraPath(g, 0, to)
+				if ok != wantOK || got != want {
+					t.Errorf(
+						"weightedgraph.DijkstraPath(0, %d): got (%d, %t), want (%d, %t)",
+						to,
+						got,
+						ok,
+						want,
+						wantOK,
+					)
+				}
+			}
+		}
+	})
+}
+
+const nodeNotInGraph = 1_000
+
+// textbookGraph returns the directed graph that is used to demonstrate
+// Dijkstra's algorithm in "Introduction to Algorithms" by Cormen et al.
+func textbookGraph() *weightedgraph.WeightedGraph[string, int] {
+	g := weightedgraph.Directed[string, int]().Build()
+	g.PutEdgeValue("s", "t", 10)
+	g.PutEdgeValue("s", "y", 5)
+	g.PutEdgeValue("t", "x", 1)
+	g.PutEdgeValue("t", "y", 2)
+	g.PutEdgeValue("y", "t", 3)
+	g.PutEdgeValue("y", "x", 9)
+	g.PutEdgeValue("y", "z", 2)
+	g.PutEdgeValue("x", "z", 4)
+	g.PutEdgeValue("z", "x", 6)
+	g.PutEdgeValue("z", "s", 7)
+	return g
+}
+
+// randomWeightedGraph returns a directed graph with the given number of nodes,
+// numbered from 0, and edges between random pairs of different nodes with
+// random weights between minWeight and maxWeight inclusive.
+func randomWeightedGraph(
+	r *rand.Rand,
+	nodes int,
+	edges int,
+	minWeight int,
+	maxWeight int,
+) *weightedgraph.WeightedGraph[int, int] {
+	g := weightedgraph.Directed[int, int]().Build()
+	for node := range nodes {
+		g.AddNode(node)
+	}
+	for g.Edges().Len() < edges {
+		source, target := r.IntN(nodes), r.IntN(nodes)
+		if source != target {
+			g.PutEdgeValue(source, target, minWeight+r.IntN(maxWeight-minWeight+1))
+		}
+	}
+	return g
+}
+
+// relaxedDistances returns the shortest distances from node from in graph g by
+// relaxing every edge until no distance changes, which is slow but simple
+// enough to check other algorithms against.
+func relaxedDistances(
+	g *weightedgraph.WeightedGraph[int, int],
+	from int,
+) map[int]int {
+	result := map[int]int{from: 0}
+	for changed := true; changed; {
+		changed = false
+		for edge := range g.Edges().All() {
+			source, target := edge.Source(), edge.Target()
+			sourceDistance, ok := result[source]
+			if !ok {
+				continue
+			}
+			weight, _ := g.EdgeValue(source, target)
+			if targetDistance, ok := result[target]; !ok || sourceDistance+weight < targetDistance {
+				result[target] = sourceDistance + weight
+				changed = true
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package weightedgraph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+// ErrNegativeCycle is returned, wrapped in a *NegativeCycleError, by functions
+// that find shortest paths when there is a cycle with a negative total weight
+// on the way, because such paths can be made as short as one likes by going
+// around the cycle again.
+var ErrNegativeCycle = errors.New("graph has a negative cycle")
+
+// NegativeCycleError records a cycle with a negative total weight and the
+// nodes whose distance is negative infinity because of it, or because of any
+// other such cycle. It wraps ErrNegativeCycle.
+type NegativeCycleError[N comparable] struct {
+	// Cycle is the nodes of the cycle in order. Each node has an edge to the
+	// next one, and the last node has an edge to the first one.
+	Cycle []N
+
+	// NegativeInfinity is the set of nodes that can be reached from a cycle
+	// with a negative total weight, including the nodes of the cycles
+	// themselves, so that there is no shortest path to them. It is a
+	// read-only snapshot.
+	NegativeInfinity graph.SetView[N]
+}
+
+func (e *NegativeCycleError[N]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNegativeCycle, e.Cycle)
+}
+
+func (e *NegativeCycleError[N]) Unwrap() error {
+	return ErrNegativeCycle
+}
@@ -0,0 +1,36 @@
+package weightedgraph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestNegativeCycleError(t *testing.T) {
+	t.Parallel()
+
+	g := weightedgraph.Directed[string, int]().AllowsSelfLoops(true).Build()
+	g.PutEdgeValue("a", "a", -1)
+
+	_, err := weightedgraph.BellmanFord(g, "a")
+
+	var cycleErr *weightedgraph.NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf(
+			"weightedgraph.BellmanFord: got error %v, want a *NegativeCycleError",
+			err,
+		)
+	}
+	if !errors.Is(err, weightedgraph.ErrNegativeCycle) {
+		t.Errorf(
+			"weightedgraph.BellmanFord: got error %v, want %v",
+			err,
+			weightedgraph.ErrNegativeCycle,
+		)
+	}
+	want := "graph has a negative cycle: [a]"
+	if got := err.Error(); got != want {
+		t.Errorf("NegativeCycleError.Error: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package weightedgraph
+
+import (
+	"slices"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+// FloydWarshall returns the shortest paths between every pair of nodes in
+// graph g, where the weight of each edge is its value. The weights may be
+// negative. Use UnitWeighted to find the shortest paths in an unweighted
+// graph.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes, so an undirected edge with a negative weight is a
+// cycle with a negative total weight.
+//
+// If g has a cycle with a negative total weight, FloydWarshall returns nil and
+// a *NegativeCycleError holding one such cycle and every node that is
+// reachable from such a cycle.
+//
+// FloydWarshall runs in O(V^3) time and O(V^2) space, which suits dense graphs
+// best; see Johnson for sparse graphs.
+func FloydWarshall[N comparable, W Weight](
+	g GraphView[N, W],
+) (*AllPairsShortestPaths[N, W], error) {
+	return FloydWarshallFunc(g, identity[W])
+}
+
+// FloydWarshallFunc is like FloydWarshall, but the weight of each edge is the
+// result of calling weight with its value, so it works with any type of edge
+// value.
+func FloydWarshallFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	weight func(value V) W,
+) (*AllPairsShortestPaths[N, W], error) {
+	edgeWeight := edgeWeightFunc(g, weight)
+	result := newAllPairsShortestPaths[N, W](slices.Collect(g.Nodes().All()))
+	distances, nextHops := result.distances, result.nextHops
+	for i, node := range result.nodes {
+		for successor := range g.Successors(node).All() {
+			j := result.nodeToIndex[successor]
+			successorWeight := edgeWeight(node, successor)
+			if i == j && successorWeight >= 0 {
+				// A self-loop is never shorter than staying put.
+				continue
+			}
+			distances[i][j] = successorWeight
+			nextHops[i][j] = j
+		}
+	}
+
+	for k := range result.nodes {
+		for i := range result.nodes {
+			if nextHops[i][k] == -1 {
+				continue
+			}
+			for j := range result.nodes {
+				if nextHops[k][j] == -1 {
+					continue
+				}
+				distance := distances[i][k] + distances[k][j]
+				if nextHops[i][j] == -1 || distance < distances[i][j] {
+					distances[i][j] = distance
+					nextHops[i][j] = nextHops[i][k]
+				}
+			}
+			if distances[i][i] < 0 {
+				// Stop early, since the distances through a negative cycle only
+				// get lower from here on and may overflow.
+				return nil, negativeCycleError(g, edgeWeight)
+			}
+		}
+	}
+	return result, nil
+}
+
+// negativeCycleError returns a *NegativeCycleError for graph g, which must have
+// a cycle with a negative total weight.
+func negativeCycleError[N comparable, W Weight](
+	g graph.GraphView[N],
+	edgeWeight func(source N, target N) W,
+) error {
+	tree := newPotentialTree[N, W](g)
+	return bellmanFord(g, tree, edgeWeight, g.Nodes().Len())
+}
+
+// newPotentialTree returns a tree in which every node of g has a distance of
+// zero, as if they were all reached from an extra node that has an edge with a
+// weight of zero to each of them. Using it with bellmanFord finds a potential
+// for each node, or every negative cycle in g.
+func newPotentialTree[N comparable, W Weight](
+	g graph.GraphView[N],
+) *ShortestPathTree[N, W] {
+	// The extra node is not in g, so the root is left as the zero value.
+	var root N
+	result := newShortestPathTree[N, W](root)
+	for node := range g.Nodes().All() {
+		result.nodeToDistance[node] = 0
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package weightedgraph
+
+import "slices"
+
+// Johnson returns the shortest paths between every pair of nodes in graph g,
+// where the weight of each edge is its value. The weights may be negative.
+// Use UnitWeighted to find the shortest paths in an unweighted graph.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes, so an undirected edge with a negative weight is a
+// cycle with a negative total weight.
+//
+// If g has a cycle with a negative total weight, Johnson returns nil and a
+// *NegativeCycleError holding one such cycle and every node that is reachable
+// from such a cycle.
+//
+// Johnson runs Bellman-Ford once and then Dijkstra from every node, in
+// O(V * E log V) time, which suits sparse graphs best; see FloydWarshall for
+// dense graphs. It uses O(V^2) space for the result.
+func Johnson[N comparable, W Weight](
+	g GraphView[N, W],
+) (*AllPairsShortestPaths[N, W], error) {
+	return JohnsonFunc(g, identity[W])
+}
+
+// JohnsonFunc is like Johnson, but the weight of each edge is the result of
+// calling weight with its value, so it works with any type of edge value.
+func JohnsonFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	weight func(value V) W,
+) (*AllPairsShortestPaths[N, W], error) {
+	edgeWeight := edgeWeightFunc(g, weight)
+
+	// Find a potential for each node such that reweighting each edge from u to
+	// v by potential(u) - potential(v) makes every weight non-negative while
+	// keeping the same shortest paths, so that Dijkstra can be used.
+	potentials := newPotentialTree[N, W](g)
+	if err := bellmanFord(g, potentials, edgeWeight, g.Nodes().Len()); err != nil {
+		return nil, err
+	}
+	potential := potentials.nodeToDistance
+	reweighted := func(source N, target N) W {
+		result := edgeWeight(source, target) + potential[source] - potential[target]
+		if result < 0 {
+			// Only rounding errors in floating-point weights can get here.
+			return 0
+		}
+		return result
+	}
+
+	result := newAllPairsShortestPaths[N, W](slices.Collect(g.Nodes().All()))
+	for i, from := range result.nodes {
+		tree := newShortestPathTree[N, W](from)
+		dijkstra(successorsFunc(g), tree, reweighted, nil)
+		for to, distance := range tree.nodeToDistance {
+			j := result.nodeToIndex[to]
+			result.distances[i][j] = distance - potential[from] + potential[to]
+			setNextHops(result, tree, i, to)
+		}
+	}
+	return result, nil
+}
+
+// setNextHops sets the next hops from nodes[i], the root of tree, to node and
+// to every node before it on its path in tree whose next hop is not yet set.
+func setNextHops[N comparable, W Weight](
+	a *AllPairsShortestPaths[N, W],
+	tree *ShortestPathTree[N, W],
+	i int,
+	node N,
+) {
+	// Go back along the path until the next hop is known, either because it
+	// was set already or because the node's predecessor is the root.
+	var path []int
+	nextHop := -1
+	for nextHop == -1 {
+		j := a.nodeToIndex[node]
+		if a.nextHops[i][j] != -1 {
+			nextHop = a.nextHops[i][j]
+			break
+		}
+		path = append(path, j)
+		predecessor := tree.nodeToPredecessor[node]
+		if predecessor == tree.root {
+			nextHop = j
+		}
+		node = predecessor
+	}
+	for _, j := range path {
+		a.nextHops[i][j] = nextHop
+	}
+}
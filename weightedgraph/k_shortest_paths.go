@@ -0,0 +1,166 @@
+package weightedgraph
+
+import (
+	"container/heap"
+	"iter"
+	"slices"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+// KShortestPaths returns an iter.Seq2 of the loopless paths from node from to
+// node to in graph g, where the weight of each edge is its value, in order of
+// increasing total weight. Each path is a slice of nodes that starts with from
+// and ends with to, and is returned with its total weight. If to is not
+// reachable from from, or if either node is not in g, no paths are returned.
+//
+// The paths are found lazily with Yen's algorithm, so callers can stop after as
+// many as they need; finding each path after the first runs Dijkstra once for
+// each node of the path before it. Paths with the same total weight are
+// returned in order of increasing length, and otherwise in an undefined order.
+//
+// If g is directed, paths follow the direction of g's edges, otherwise they
+// follow g.AdjacentNodes. KShortestPaths panics if it finds an edge with a
+// negative weight.
+func KShortestPaths[N comparable, W Weight](
+	g GraphView[N, W],
+	from N,
+	to N,
+) iter.Seq2[[]N, W] {
+	return KShortestPathsFunc(g, from, to, identity[W])
+}
+
+// KShortestPathsFunc is like KShortestPaths, but the weight of each edge is
+// the result of calling weight with its value, so it works with any type of
+// edge value.
+func KShortestPathsFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	from N,
+	to N,
+	weight func(value V) W,
+) iter.Seq2[[]N, W] {
+	return func(yield func([]N, W) bool) {
+		path, distance, ok := DijkstraPathFunc(g, from, to, weight)
+		if !ok {
+			return
+		}
+
+		edgeWeight := edgeWeightFunc(g, weight)
+		var found [][]N
+		candidates := &candidatePaths[N, W]{}
+		for {
+			if !yield(slices.Clone(path), distance) {
+				return
+			}
+			found = append(found, path)
+			addSpurPaths(g, edgeWeight, to, found, candidates)
+			if candidates.Len() == 0 {
+				return
+			}
+			next := heap.Pop(candidates).(candidatePath[N, W])
+			path, distance = next.path, next.distance
+		}
+	}
+}
+
+// addSpurPaths adds to candidates the paths that leave the last path in found
+// at each of its nodes, called the spur node, with a shortest path to to that
+// is not the same as any path in found and does not go back through the nodes
+// before the spur node.
+func addSpurPaths[N comparable, W Weight](
+	g graph.GraphView[N],
+	edgeWeight func(source N, target N) W,
+	to N,
+	found [][]N,
+	candidates *candidatePaths[N, W],
+) {
+	last := found[len(found)-1]
+	var rootDistance W
+	for i := range len(last) - 1 {
+		spurNode, root := last[i], last[:i+1]
+
+		// The edges that the paths already found with the same root take
+		// from the spur node.
+		removedEdges := make(map[N]bool)
+		for _, path := range found {
+			if len(path) > i+1 && slices.Equal(path[:i+1], root) {
+				removedEdges[path[i+1]] = true
+			}
+		}
+		removedNodes := make(map[N]bool, i)
+		for _, node := range root[:i] {
+			removedNodes[node] = true
+		}
+		successors := func(node N) iter.Seq[N] {
+			return func(yield func(N) bool) {
+				for next := range g.Successors(node).All() {
+					if removedNodes[next] || node == spurNode && removedEdges[next] {
+						continue
+					}
+					if !yield(next) {
+						return
+					}
+				}
+			}
+		}
+
+		tree := newShortestPathTree[N, W](spurNode)
+		dijkstra(successors, tree, edgeWeight, &to)
+		if spurPath, ok := tree.PathTo(to); ok {
+			spurDistance, _ := tree.Distance(to)
+			candidates.add(candidatePath[N, W]{
+				path:     append(slices.Clone(root[:i]), spurPath...),
+				distance: rootDistance + spurDistance,
+			})
+		}
+
+		rootDistance += edgeWeight(last[i], last[i+1])
+	}
+}
+
+type candidatePath[N comparable, W Weight] struct {
+	path     []N
+	distance W
+}
+
+// candidatePaths is a binary min-heap of paths ordered by distance and then by
+// length, for use with container/heap.
+type candidatePaths[N comparable, W Weight] []candidatePath[N, W]
+
+// add pushes candidate onto this heap, unless it has the same path as one
+// that is already in it.
+func (c *candidatePaths[N, W]) add(candidate candidatePath[N, W]) {
+	for _, other := range *c {
+		if slices.Equal(other.path, candidate.path) {
+			return
+		}
+	}
+	heap.Push(c, candidate)
+}
+
+func (c candidatePaths[N, W]) Len() int {
+	return len(c)
+}
+
+func (c candidatePaths[N, W]) Less(i, j int) bool {
+	if c[i].distance != c[j].distance {
+		return c[i].distance < c[j].distance
+	}
+	return len(c[i].path) < len(c[j].path)
+}
+
+func (c candidatePaths[N, W]) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
+
+func (c *candidatePaths[N, W]) Push(x any) {
+	*c = append(*c, x.(candidatePath[N, W]))
+}
+
+func (c *candidatePaths[N, W]) Pop() any {
+	old := *c
+	n := len(old)
+	result := old[n-1]
+	*c = old[:n-1]
+	return result
+}
@@ -0,0 +1,242 @@
+package weightedgraph_test
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestKShortestPaths(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the paths of a textbook graph in order", func(t *testing.T) {
+		t.Parallel()
+
+		var gotPaths [][]string
+		var gotDistances []int
+		for path, distance := range weightedgraph.KShortestPaths(yenGraph(), "C", "H") {
+			gotPaths = append(gotPaths, path)
+			gotDistances = append(gotDistances, distance)
+		}
+
+		wantFirstPaths := [][]string{
+			{"C", "E", "F", "H"},
+			{"C", "E", "G", "H"},
+			{"C", "D", "F", "H"},
+		}
+		if len(gotPaths) < 3 || !slices.EqualFunc(gotPaths[:3], wantFirstPaths, slices.Equal) {
+			t.Errorf(
+				"weightedgraph.KShortestPaths: got paths %v, want them to start with %v",
+				gotPaths,
+				wantFirstPaths,
+			)
+		}
+		wantDistances := []int{5, 7, 8, 8, 8, 11, 11}
+		if !slices.Equal(gotDistances, wantDistances) {
+			t.Errorf(
+				"weightedgraph.KShortestPaths: got distances %v, want %v",
+				gotDistances,
+				wantDistances,
+			)
+		}
+	})
+
+	t.Run("stops when the caller stops", func(t *testing.T) {
+		t.Parallel()
+
+		var got [][]string
+		for path := range weightedgraph.KShortestPaths(yenGraph(), "C", "H") {
+			got = append(got, path)
+			if len(got) == 2 {
+				break
+			}
+		}
+
+		want := [][]string{
+			{"C", "E", "F", "H"},
+			{"C", "E", "G", "H"},
+		}
+		if !slices.EqualFunc(got, want, slices.Equal) {
+			t.Errorf("weightedgraph.KShortestPaths: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("from a node to itself", func(t *testing.T) {
+		t.Parallel()
+
+		got := collectPaths(weightedgraph.KShortestPaths(yenGraph(), "E", "E"))
+
+		want := []weightedPath[string]{{nodes: []string{"E"}, distance: 0}}
+		if !slices.EqualFunc(got, want, weightedPathsEqual) {
+			t.Errorf("weightedgraph.KShortestPaths: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("to an unreachable node", func(t *testing.T) {
+		t.Parallel()
+
+		got := collectPaths(weightedgraph.KShortestPaths(yenGraph(), "H", "C"))
+
+		if len(got) != 0 {
+			t.Errorf("weightedgraph.KShortestPaths: got %v, want no paths", got)
+		}
+	})
+
+	t.Run("to an absent node", func(t *testing.T) {
+		t.Parallel()
+
+		got := collectPaths(weightedgraph.KShortestPaths(yenGraph(), "C", "absent"))
+
+		if len(got) != 0 {
+			t.Errorf("weightedgraph.KShortestPaths: got %v, want no paths", got)
+		}
+	})
+
+	t.Run("in an undirected graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[int, int]().Build()
+		g.PutEdgeValue(1, 2, 1)
+		g.PutEdgeValue(2, 3, 1)
+		g.PutEdgeValue(3, 1, 5)
+
+		got := collectPaths(weightedgraph.KShortestPaths(g, 3, 1))
+
+		want := []weightedPath[int]{
+			{nodes: []int{3, 2, 1}, distance: 2},
+			{nodes: []int{3, 1}, distance: 5},
+		}
+		if !slices.EqualFunc(got, want, weightedPathsEqual) {
+			t.Errorf("weightedgraph.KShortestPaths: got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("returns every loopless path on random graphs", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(19, 20))
+		for range 20 {
+			g := randomWeightedGraph(r, 8, 20, 0, 10)
+
+			got := collectPaths(weightedgraph.KShortestPaths(g, 0, 7))
+
+			want := looplessPaths(g, 0, 7)
+			if !slices.IsSortedFunc(got, compareDistances) {
+				t.Errorf(
+					"weightedgraph.KShortestPaths: got %v, want paths in order of distance",
+					got,
+				)
+			}
+			slices.SortFunc(got, comparePaths)
+			slices.SortFunc(want, comparePaths)
+			if !slices.EqualFunc(got, want, weightedPathsEqual) {
+				t.Errorf("weightedgraph.KShortestPaths: got %v, want %v", got, want)
+			}
+		}
+	})
+}
+
+func TestKShortestPathsFunc(t *testing.T) {
+	t.Parallel()
+
+	type link struct {
+		hops int
+	}
+	g := weightedgraph.Directed[string, link]().Build()
+	g.PutEdgeValue("a", "b", link{hops: 1})
+	g.PutEdgeValue("b", "c", link{hops: 1})
+	g.PutEdgeValue("a", "c", link{hops: 3})
+
+	got := collectPaths(weightedgraph.KShortestPathsFunc(
+		g,
+		"a",
+		"c",
+		func(l link) int { return l.hops },
+	))
+
+	want := []weightedPath[string]{
+		{nodes: []string{"a", "b", "c"}, distance: 2},
+		{nodes: []string{"a", "c"}, distance: 3},
+	}
+	if !slices.EqualFunc(got, want, weightedPathsEqual) {
+		t.Errorf("weightedgraph.KShortestPathsFunc: got %v, want %v", got, want)
+	}
+}
+
+type weightedPath[N comparable] struct {
+	nodes    []N
+	distance int
+}
+
+func collectPaths[N comparable](
+	paths iter.Seq2[[]N, int],
+) []weightedPath[N] {
+	var result []weightedPath[N]
+	for nodes, distance := range paths {
+		result = append(result, weightedPath[N]{nodes: nodes, distance: distance})
+	}
+	return result
+}
+
+func weightedPathsEqual[N comparable](a, b weightedPath[N]) bool {
+	return a.distance == b.distance && slices.Equal(a.nodes, b.nodes)
+}
+
+func compareDistances[N comparable](a, b weightedPath[N]) int {
+	return cmp.Compare(a.distance, b.distance)
+}
+
+func comparePaths(a, b weightedPath[int]) int {
+	return cmp.Or(compareDistances(a, b), slices.Compare(a.nodes, b.nodes))
+}
+
+// looplessPaths returns every loopless path from node from to node to in g by
+// trying every one of them, which is slow but simple enough to check
+// KShortestPaths against.
+func looplessPaths(
+	g *weightedgraph.WeightedGraph[int, int],
+	from int,
+	to int,
+) []weightedPath[int] {
+	var result []weightedPath[int]
+	var visit func(path []int, distance int)
+	visit = func(path []int, distance int) {
+		node := path[len(path)-1]
+		if node == to {
+			result = append(result, weightedPath[int]{
+				nodes:    slices.Clone(path),
+				distance: distance,
+			})
+			return
+		}
+		for next := range g.Successors(node).All() {
+			if slices.Contains(path, next) {
+				continue
+			}
+			weight, _ := g.EdgeValue(node, next)
+			visit(append(path, next), distance+weight)
+		}
+	}
+	visit([]int{from}, 0)
+	return result
+}
+
+// yenGraph returns the directed graph that is used to demonstrate Yen's
+// algorithm on Wikipedia.
+func yenGraph() *weightedgraph.WeightedGraph[string, int] {
+	g := weightedgraph.Directed[string, int]().Build()
+	g.PutEdgeValue("C", "D", 3)
+	g.PutEdgeValue("C", "E", 2)
+	g.PutEdgeValue("D", "F", 4)
+	g.PutEdgeValue("E", "D", 1)
+	g.PutEdgeValue("E", "F", 2)
+	g.PutEdgeValue("E", "G", 3)
+	g.PutEdgeValue("F", "G", 2)
+	g.PutEdgeValue("F", "H", 1)
+	g.PutEdgeValue("G", "H", 2)
+	return g
+}
@@ -0,0 +1,83 @@
+package weightedgraph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/jbduncan/go-containers/graph"
+)
+
+// Kruskal returns a minimum spanning forest of undirected graph g, where the
+// weight of each edge is its value, as a new undirected graph, along with its
+// total weight. The forest has every node of g and, for each connected
+// component of g, a tree of edges that connects the nodes of that component
+// with the least total weight possible. If g is connected, the forest is a
+// minimum spanning tree.
+//
+// The edges of the forest have the same values as in g. If there is more than
+// one minimum spanning forest, which one is returned is undefined. Self-loops
+// are never part of it.
+//
+// Kruskal adds the lightest edges first as long as they do not make a cycle,
+// which it checks with a disjoint-set forest, so it runs in O(E log E) time.
+// It returns graph.ErrDirected if g is directed.
+//
+// The returned graph is a copy; it does not reflect later changes to g.
+func Kruskal[N comparable, W Weight](
+	g GraphView[N, W],
+) (*WeightedGraph[N, W], W, error) {
+	return KruskalFunc(g, identity[W])
+}
+
+// KruskalFunc is like Kruskal, but the weight of each edge is the result of
+// calling weight with its value, so it works with any type of edge value.
+func KruskalFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	weight func(value V) W,
+) (*WeightedGraph[N, V], W, error) {
+	if g.IsDirected() {
+		var zero W
+		return nil, zero, graph.ErrDirected
+	}
+
+	type weightedEdge struct {
+		edge   graph.EndpointPair[N]
+		value  V
+		weight W
+	}
+	edges := make([]weightedEdge, 0, g.Edges().Len())
+	for edge := range g.Edges().All() {
+		value, _ := g.EdgeValue(edge.Source(), edge.Target())
+		edges = append(edges, weightedEdge{
+			edge:   edge,
+			value:  value,
+			weight: weight(value),
+		})
+	}
+	slices.SortFunc(edges, func(a, b weightedEdge) int {
+		return cmp.Compare(a.weight, b.weight)
+	})
+
+	result := newSpanningForest[N, V](g)
+	var totalWeight W
+	components := newUnionFind[N]()
+	for _, e := range edges {
+		if components.union(e.edge.Source(), e.edge.Target()) {
+			result.PutEdgeValue(e.edge.Source(), e.edge.Target(), e.value)
+			totalWeight += e.weight
+		}
+	}
+	return result, totalWeight, nil
+}
+
+// newSpanningForest returns a new undirected graph with the nodes of g and no
+// edges.
+func newSpanningForest[N comparable, V any](
+	g graph.GraphView[N],
+) *WeightedGraph[N, V] {
+	result := Undirected[N, V]().Build()
+	for node := range g.Nodes().All() {
+		result.AddNode(node)
+	}
+	return result
+}
@@ -0,0 +1,199 @@
+package weightedgraph
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+// MaxFlow is a maximum flow from a source node to a sink node in a directed
+// graph whose edge weights are capacities, as found by EdmondsKarp, along
+// with a minimum cut.
+type MaxFlow[N comparable, W Weight] struct {
+	value      W
+	edgeToFlow map[graph.EndpointPair[N]]W
+	sourceSide set.Set[N]
+	sinkSide   set.Set[N]
+}
+
+// Value returns the total flow out of the source, which is the same as the
+// total flow into the sink and the total capacity of the minimum cut.
+func (f *MaxFlow[N, W]) Value() W {
+	return f.value
+}
+
+// EdgeFlow returns the flow along the edge from source to target, which is
+// between zero and the edge's capacity, or zero if there is no such edge.
+func (f *MaxFlow[N, W]) EdgeFlow(source N, target N) W {
+	return f.edgeToFlow[graph.EndpointPairOf(source, target)]
+}
+
+// EdgeFlows returns a new map from every edge of the graph to its flow.
+func (f *MaxFlow[N, W]) EdgeFlows() map[graph.EndpointPair[N]]W {
+	return maps.Clone(f.edgeToFlow)
+}
+
+// MinCut returns a minimum cut, which splits the nodes of the graph into the
+// nodes on the source's side and the nodes on the sink's side such that the
+// total capacity of the edges from the source's side to the sink's side is as
+// small as possible. Those edges are all saturated by the flow.
+func (f *MaxFlow[N, W]) MinCut() (
+	sourceSide graph.SetView[N],
+	sinkSide graph.SetView[N],
+) {
+	return set.Unmodifiable[N](f.sourceSide), set.Unmodifiable[N](f.sinkSide)
+}
+
+// EdmondsKarp returns a maximum flow from node source to node sink in directed
+// graph g, where the capacity of each edge is its value, along with a minimum
+// cut between them. Self-loops are ignored, since they can never carry any
+// flow from source to sink.
+//
+// EdmondsKarp repeatedly sends flow along a shortest path with spare capacity,
+// so it runs in O(V * E^2) time.
+//
+// EdmondsKarp returns graph.ErrUndirected if g is undirected. It panics if
+// source or sink is not in g, if source and sink are the same node, or if an
+// edge has a negative capacity.
+func EdmondsKarp[N comparable, W Weight](
+	g GraphView[N, W],
+	source N,
+	sink N,
+) (*MaxFlow[N, W], error) {
+	return EdmondsKarpFunc(g, source, sink, identity[W])
+}
+
+// EdmondsKarpFunc is like EdmondsKarp, but the capacity of each edge is the
+// result of calling capacity with its value, so it works with any type of edge
+// value.
+func EdmondsKarpFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	source N,
+	sink N,
+	capacity func(value V) W,
+) (*MaxFlow[N, W], error) {
+	if !g.IsDirected() {
+		return nil, graph.ErrUndirected
+	}
+	for _, node := range []N{source, sink} {
+		if !g.Nodes().Contains(node) {
+			panic(fmt.Sprintf("node %v is not an element of this graph", node))
+		}
+	}
+	if source == sink {
+		panic(fmt.Sprintf("source and sink are the same node %v", source))
+	}
+
+	// residual has the spare capacity from each node to each of its
+	// neighbors, which includes the flow that can be pushed back along an
+	// edge in the other direction.
+	capacityOf := edgeWeightFunc(g, capacity)
+	residual := make(map[graph.EndpointPair[N]]W)
+	for edge := range g.Edges().All() {
+		if edge.Source() == edge.Target() {
+			continue
+		}
+		edgeCapacity := capacityOf(edge.Source(), edge.Target())
+		if edgeCapacity < 0 {
+			panic(fmt.Sprintf(
+				"edge %v has a negative capacity %v",
+				edge,
+				edgeCapacity,
+			))
+		}
+		residual[edge] = edgeCapacity
+	}
+
+	var value W
+	for {
+		nodeToParent := residualPaths(g, residual, source)
+		if _, ok := nodeToParent[sink]; !ok {
+			return newMaxFlow(g, capacityOf, residual, value, nodeToParent), nil
+		}
+
+		// Send as much flow as the pair of nodes with the least spare
+		// capacity along the path allows.
+		bottleneck := residual[graph.EndpointPairOf(nodeToParent[sink], sink)]
+		for node := sink; node != source; node = nodeToParent[node] {
+			spare := residual[graph.EndpointPairOf(nodeToParent[node], node)]
+			bottleneck = min(bottleneck, spare)
+		}
+		for node := sink; node != source; node = nodeToParent[node] {
+			parent := nodeToParent[node]
+			residual[graph.EndpointPairOf(parent, node)] -= bottleneck
+			residual[graph.EndpointPairOf(node, parent)] += bottleneck
+		}
+		value += bottleneck
+	}
+}
+
+// residualPaths runs a breadth-first search from source along the pairs of
+// nodes with spare capacity in residual, and returns the parent of each node
+// that it reaches, including source itself, which is its own parent.
+func residualPaths[N comparable, W Weight](
+	g graph.GraphView[N],
+	residual map[graph.EndpointPair[N]]W,
+	source N,
+) map[N]N {
+	nodeToParent := map[N]N{source: source}
+	queue := []N{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		// Flow can be pushed forwards along an edge or back against one.
+		forwardsAndBack := []graph.SetView[N]{
+			g.Successors(node),
+			g.Predecessors(node),
+		}
+		for _, neighbors := range forwardsAndBack {
+			for next := range neighbors.All() {
+				if _, ok := nodeToParent[next]; ok {
+					continue
+				}
+				if residual[graph.EndpointPairOf(node, next)] <= 0 {
+					continue
+				}
+				nodeToParent[next] = node
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nodeToParent
+}
+
+func newMaxFlow[N comparable, W Weight](
+	g graph.GraphView[N],
+	capacityOf func(source N, target N) W,
+	residual map[graph.EndpointPair[N]]W,
+	value W,
+	sourceSide map[N]N,
+) *MaxFlow[N, W] {
+	result := &MaxFlow[N, W]{
+		value:      value,
+		edgeToFlow: make(map[graph.EndpointPair[N]]W),
+		sourceSide: set.Of[N](),
+		sinkSide:   set.Of[N](),
+	}
+	for edge := range g.Edges().All() {
+		// If there are edges in both directions between two nodes, their
+		// residual capacities are shared, so only the net flow is kept, on
+		// the edge that it goes along.
+		var flow W
+		edgeCapacity := capacityOf(edge.Source(), edge.Target())
+		if edge.Source() != edge.Target() && residual[edge] < edgeCapacity {
+			flow = edgeCapacity - residual[edge]
+		}
+		result.edgeToFlow[edge] = flow
+	}
+	for node := range g.Nodes().All() {
+		if _, ok := sourceSide[node]; ok {
+			result.sourceSide.Add(node)
+		} else {
+			result.sinkSide.Add(node)
+		}
+	}
+	return result
+}
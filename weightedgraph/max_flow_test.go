@@ -0,0 +1,349 @@
+package weightedgraph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestEdmondsKarp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finds the maximum flow of a textbook network", func(t *testing.T) {
+		t.Parallel()
+
+		g := flowTextbookNetwork()
+
+		flow, err := weightedgraph.EdmondsKarp(g, "s", "t")
+		if err != nil {
+			t.Fatalf("weightedgraph.EdmondsKarp: got error %v, want nil", err)
+		}
+
+		if got := flow.Value(); got != 23 {
+			t.Errorf("MaxFlow.Value: got %d, want 23", got)
+		}
+		assertValidFlow(t, g, flow, "s", "t")
+		sourceSide, sinkSide := flow.MinCut()
+		wantSourceSide := set.Of("s", "v1", "v2", "v4")
+		wantSinkSide := set.Of("v3", "t")
+		if !set.Equal[string](sourceSide, wantSourceSide) ||
+			!set.Equal[string](sinkSide, wantSinkSide) {
+			t.Errorf(
+				"MaxFlow.MinCut: got (%v, %v), want (%v, %v)",
+				sourceSide,
+				sinkSide,
+				wantSourceSide,
+				wantSinkSide,
+			)
+		}
+	})
+
+	t.Run("finds the maximum flow of a network with fractional capacities", func(t *testing.T) {
+		t.Parallel()
+
+		// The tiny flow network from "Algorithms" by Sedgewick and Wayne.
+		g := weightedgraph.Directed[int, float64]().Build()
+		g.PutEdgeValue(0, 1, 2)
+		g.PutEdgeValue(0, 2, 3)
+		g.PutEdgeValue(1, 3, 3)
+		g.PutEdgeValue(1, 4, 1)
+		g.PutEdgeValue(2, 3, 1)
+		g.PutEdgeValue(2, 4, 1)
+		g.PutEdgeValue(3, 5, 2)
+		g.PutEdgeValue(4, 5, 3)
+
+		flow, err := weightedgraph.EdmondsKarp(g, 0, 5)
+		if err != nil {
+			t.Fatalf("weightedgraph.EdmondsKarp: got error %v, want nil", err)
+		}
+
+		if got := flow.Value(); got != 4 {
+			t.Errorf("MaxFlow.Value: got %v, want 4", got)
+		}
+		if got := flow.EdgeFlow(0, 2); got != 2 {
+			t.Errorf("MaxFlow.EdgeFlow: got %v, want 2", got)
+		}
+	})
+
+	t.Run("keeps the net flow between edges in both directions", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, int]().AllowsSelfLoops(true).Build()
+		g.PutEdgeValue("s", "a", 5)
+		g.PutEdgeValue("a", "b", 3)
+		g.PutEdgeValue("b", "a", 2)
+		g.PutEdgeValue("b", "t", 5)
+		g.PutEdgeValue("a", "a", 10)
+
+		flow, err := weightedgraph.EdmondsKarp(g, "s", "t")
+		if err != nil {
+			t.Fatalf("weightedgraph.EdmondsKarp: got error %v, want nil", err)
+		}
+
+		if got := flow.Value(); got != 3 {
+			t.Errorf("MaxFlow.Value: got %d, want 3", got)
+		}
+		assertValidFlow(t, g, flow, "s", "t")
+		if got := flow.EdgeFlow("b", "a"); got != 0 {
+			t.Errorf("MaxFlow.EdgeFlow: got %d, want 0", got)
+		}
+		if got := flow.EdgeFlow("a", "a"); got != 0 {
+			t.Errorf("MaxFlow.EdgeFlow: got %d for a self-loop, want 0", got)
+		}
+	})
+
+	t.Run("when the sink is unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, int]().Build()
+		g.PutEdgeValue("s", "a", 5)
+		g.PutEdgeValue("t", "a", 5)
+
+		flow, err := weightedgraph.EdmondsKarp(g, "s", "t")
+		if err != nil {
+			t.Fatalf("weightedgraph.EdmondsKarp: got error %v, want nil", err)
+		}
+
+		if got := flow.Value(); got != 0 {
+			t.Errorf("MaxFlow.Value: got %d, want 0", got)
+		}
+		sourceSide, sinkSide := flow.MinCut()
+		if !set.Equal[string](sourceSide, set.Of("s", "a")) ||
+			!set.Equal[string](sinkSide, set.Of("t")) {
+			t.Errorf("MaxFlow.MinCut: got (%v, %v), want ([s, a], [t])", sourceSide, sinkSide)
+		}
+	})
+
+	t.Run("matches the brute-force minimum cut on random networks", func(t *testing.T) {
+		t.Parallel()
+
+		r := rand.New(rand.NewPCG(23, 24))
+		for range 20 {
+			g := randomWeightedGraph(r, 8, 20, 0, 10)
+
+			flow, err := weightedgraph.EdmondsKarp(g, 0, 7)
+			if err != nil {
+				t.Fatalf("weightedgraph.EdmondsKarp: got error %v, want nil", err)
+			}
+
+			if got, want := flow.Value(), bruteForceMinCut(g, 0, 7); got != want {
+				t.Errorf("MaxFlow.Value: got %d, want %d", got, want)
+			}
+			assertValidFlow(t, g, flow, 0, 7)
+		}
+	})
+
+	t.Run("returns an error for an undirected graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, int]().Build()
+		g.PutEdgeValue("a", "b", 1)
+
+		flow, err := weightedgraph.EdmondsKarp(g, "a", "b")
+
+		if !errors.Is(err, graph.ErrUndirected) {
+			t.Errorf(
+				"weightedgraph.EdmondsKarp: got error %v, want %v",
+				err,
+				graph.ErrUndirected,
+			)
+		}
+		if flow != nil {
+			t.Errorf("weightedgraph.EdmondsKarp: got %v, want nil", flow)
+		}
+	})
+
+	for _, tt := range []struct {
+		name   string
+		source string
+		sink   string
+		g      func() *weightedgraph.WeightedGraph[string, int]
+	}{
+		{
+			name:   "panics on an absent source",
+			source: "absent",
+			sink:   "t",
+			g:      flowTextbookNetwork,
+		},
+		{
+			name:   "panics on an absent sink",
+			source: "s",
+			sink:   "absent",
+			g:      flowTextbookNetwork,
+		},
+		{
+			name:   "panics when the source is the sink",
+			source: "s",
+			sink:   "s",
+			g:      flowTextbookNetwork,
+		},
+		{
+			name:   "panics on a negative capacity",
+			source: "a",
+			sink:   "b",
+			g: func() *weightedgraph.WeightedGraph[string, int] {
+				g := weightedgraph.Directed[string, int]().Build()
+				g.PutEdgeValue("a", "b", -1)
+				return g
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := tt.g()
+
+			defer func() { _ = recover() }()
+			_, _ = weightedgraph.EdmondsKarp(g, tt.source, tt.sink)
+			t.Errorf("weightedgraph.EdmondsKarp: should have panicked")
+		})
+	}
+}
+
+func TestEdmondsKarpFunc(t *testing.T) {
+	t.Parallel()
+
+	type pipe struct {
+		litersPerSecond uint
+	}
+	g := weightedgraph.Directed[string, pipe]().Build()
+	g.PutEdgeValue("s", "a", pipe{litersPerSecond: 4})
+	g.PutEdgeValue("a", "t", pipe{litersPerSecond: 3})
+	g.PutEdgeValue("s", "t", pipe{litersPerSecond: 1})
+
+	flow, err := weightedgraph.EdmondsKarpFunc(
+		g,
+		"s",
+		"t",
+		func(p pipe) uint { return p.litersPerSecond },
+	)
+	if err != nil {
+		t.Fatalf("weightedgraph.EdmondsKarpFunc: got error %v, want nil", err)
+	}
+
+	if got := flow.Value(); got != 4 {
+		t.Errorf("MaxFlow.Value: got %d, want 4", got)
+	}
+	want := map[graph.EndpointPair[string]]uint{
+		graph.EndpointPairOf("s", "a"): 3,
+		graph.EndpointPairOf("a", "t"): 3,
+		graph.EndpointPairOf("s", "t"): 1,
+	}
+	got := flow.EdgeFlows()
+	if len(got) != len(want) {
+		t.Errorf("MaxFlow.EdgeFlows: got %v, want %v", got, want)
+	}
+	for edge, wantFlow := range want {
+		if got[edge] != wantFlow {
+			t.Errorf("MaxFlow.EdgeFlows: got %v, want %v", got, want)
+		}
+	}
+}
+
+// assertValidFlow checks that flow respects the capacities of g, that flow is
+// conserved at every node except source and sink, that its value is the flow
+// out of source, and that its minimum cut has the same capacity as its value.
+func assertValidFlow[N comparable](
+	t *testing.T,
+	g *weightedgraph.WeightedGraph[N, int],
+	flow *weightedgraph.MaxFlow[N, int],
+	source N,
+	sink N,
+) {
+	t.Helper()
+
+	nodeToNetFlow := make(map[N]int)
+	for edge, edgeFlow := range flow.EdgeFlows() {
+		capacity, _ := g.EdgeValue(edge.Source(), edge.Target())
+		if edgeFlow < 0 || edgeFlow > capacity {
+			t.Errorf(
+				"MaxFlow.EdgeFlows: got flow %d along edge %v with capacity %d",
+				edgeFlow,
+				edge,
+				capacity,
+			)
+		}
+		nodeToNetFlow[edge.Source()] -= edgeFlow
+		nodeToNetFlow[edge.Target()] += edgeFlow
+	}
+	for node, netFlow := range nodeToNetFlow {
+		if node != source && node != sink && netFlow != 0 {
+			t.Errorf("MaxFlow.EdgeFlows: got net flow %d into node %v, want 0", netFlow, node)
+		}
+	}
+	if got, want := -nodeToNetFlow[source], flow.Value(); got != want {
+		t.Errorf("MaxFlow.EdgeFlows: got %d out of the source, want %d", got, want)
+	}
+
+	sourceSide, sinkSide := flow.MinCut()
+	if !sourceSide.Contains(source) || !sinkSide.Contains(sink) ||
+		sourceSide.Len()+sinkSide.Len() != g.Nodes().Len() {
+		t.Errorf("MaxFlow.MinCut: got (%v, %v), want a partition of the nodes", sourceSide, sinkSide)
+	}
+	if got, want := cutCapacity(g, sourceSide), flow.Value(); got != want {
+		t.Errorf("MaxFlow.MinCut: got a cut with capacity %d, want %d", got, want)
+	}
+}
+
+// cutCapacity returns the total capacity of the edges of g from the nodes in
+// sourceSide to the nodes not in it.
+func cutCapacity[N comparable](
+	g *weightedgraph.WeightedGraph[N, int],
+	sourceSide graph.SetView[N],
+) int {
+	result := 0
+	for edge := range g.Edges().All() {
+		if sourceSide.Contains(edge.Source()) && !sourceSide.Contains(edge.Target()) {
+			capacity, _ := g.EdgeValue(edge.Source(), edge.Target())
+			result += capacity
+		}
+	}
+	return result
+}
+
+// bruteForceMinCut returns the capacity of the minimum cut between source and
+// sink in g, whose nodes are the integers from 0 to g.Nodes().Len() - 1, by
+// trying every cut.
+func bruteForceMinCut(
+	g *weightedgraph.WeightedGraph[int, int],
+	source int,
+	sink int,
+) int {
+	result := -1
+	nodes := g.Nodes().Len()
+	for mask := range 1 << nodes {
+		if mask&(1<<source) == 0 || mask&(1<<sink) != 0 {
+			continue
+		}
+		sourceSide := set.Of[int]()
+		for node := range nodes {
+			if mask&(1<<node) != 0 {
+				sourceSide.Add(node)
+			}
+		}
+		if capacity := cutCapacity(g, sourceSide); result == -1 || capacity < result {
+			result = capacity
+		}
+	}
+	return result
+}
+
+// flowTextbookNetwork returns the flow network that is used to demonstrate
+// maximum flows in "Introduction to Algorithms" by Cormen et al.
+func flowTextbookNetwork() *weightedgraph.WeightedGraph[string, int] {
+	g := weightedgraph.Directed[string, int]().Build()
+	g.PutEdgeValue("s", "v1", 16)
+	g.PutEdgeValue("s", "v2", 13)
+	g.PutEdgeValue("v2", "v1", 4)
+	g.PutEdgeValue("v1", "v3", 12)
+	g.PutEdgeValue("v3", "v2", 9)
+	g.PutEdgeValue("v2", "v4", 14)
+	g.PutEdgeValue("v4", "v3", 7)
+	g.PutEdgeValue("v3", "t", 20)
+	g.PutEdgeValue("v4", "t", 4)
+	return g
+}
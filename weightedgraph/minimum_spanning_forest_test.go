@@ -0,0 +1,344 @@
+package weightedgraph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+var minimumSpanningForestFuncs = []struct {
+	name string
+	find func(
+		g weightedgraph.GraphView[string, int],
+	) (*weightedgraph.WeightedGraph[string, int], int, error)
+}{
+	{
+		name: "Kruskal",
+		find: weightedgraph.Kruskal[string, int],
+	},
+	{
+		name: "Prim",
+		find: weightedgraph.Prim[string, int],
+	},
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range minimumSpanningForestFuncs {
+		t.Run(f.name, func(t *testing.T) {
+			t.Parallel()
+
+			testMinimumSpanningForest(t, f.name, f.find)
+		})
+	}
+}
+
+func testMinimumSpanningForest(
+	t *testing.T,
+	name string,
+	find func(
+		g weightedgraph.GraphView[string, int],
+	) (*weightedgraph.WeightedGraph[string, int], int, error),
+) {
+	t.Run("finds a minimum spanning tree of a textbook graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := mstTextbookGraph()
+
+		forest, totalWeight, err := find(g)
+
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		if totalWeight != 37 {
+			t.Errorf("weightedgraph.%s: got total weight %d, want 37", name, totalWeight)
+		}
+		assertSpanningForest(t, name, g, forest, totalWeight, 1)
+	})
+
+	t.Run("finds a spanning forest of a disconnected graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, int]().AllowsSelfLoops(true).Build()
+		g.PutEdgeValue("a", "b", 3)
+		g.PutEdgeValue("b", "c", 1)
+		g.PutEdgeValue("a", "c", 1)
+		g.PutEdgeValue("x", "y", 5)
+		g.PutEdgeValue("y", "y", -10)
+		g.AddNode("lonely")
+
+		forest, totalWeight, err := find(g)
+
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		if totalWeight != 7 {
+			t.Errorf("weightedgraph.%s: got total weight %d, want 7", name, totalWeight)
+		}
+		assertSpanningForest(t, name, g, forest, totalWeight, 3)
+		if forest.AllowsSelfLoops() {
+			t.Errorf("weightedgraph.%s: got a forest that allows self-loops", name)
+		}
+	})
+
+	t.Run("of an empty graph", func(t *testing.T) {
+		t.Parallel()
+
+		forest, totalWeight, err := find(weightedgraph.Undirected[string, int]().Build())
+
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		if forest.Nodes().Len() != 0 || totalWeight != 0 {
+			t.Errorf(
+				"weightedgraph.%s: got (%v, %d), want an empty graph and 0",
+				name,
+				forest,
+				totalWeight,
+			)
+		}
+	})
+
+	t.Run("allows negative weights", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, int]().Build()
+		g.PutEdgeValue("a", "b", -1)
+		g.PutEdgeValue("b", "c", -2)
+		g.PutEdgeValue("a", "c", 0)
+
+		forest, totalWeight, err := find(g)
+
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", name, err)
+		}
+
+		if totalWeight != -3 {
+			t.Errorf("weightedgraph.%s: got total weight %d, want -3", name, totalWeight)
+		}
+		assertSpanningForest(t, name, g, forest, totalWeight, 1)
+	})
+
+	t.Run("does not reflect later changes", func(t *testing.T) {
+		t.Parallel()
+
+		g := mstTextbookGraph()
+		forest, _, _ := find(g)
+
+		g.PutEdgeValue("a", "i", 0)
+
+		if forest.HasEdgeConnecting("a", "i") || forest.Nodes().Len() != 9 {
+			t.Errorf("weightedgraph.%s: got %v, want it unchanged", name, forest)
+		}
+	})
+
+	t.Run("returns an error for a directed graph", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, int]().Build()
+		g.PutEdgeValue("a", "b", 1)
+
+		forest, totalWeight, err := find(g)
+
+		if !errors.Is(err, graph.ErrDirected) {
+			t.Errorf(
+				"weightedgraph.%s: got error %v, want %v",
+				name,
+				err,
+				graph.ErrDirected,
+			)
+		}
+		if forest != nil || totalWeight != 0 {
+			t.Errorf(
+				"weightedgraph.%s: got (%v, %d), want (nil, 0)",
+				name,
+				forest,
+				totalWeight,
+			)
+		}
+	})
+}
+
+func TestKruskalMatchesPrim(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(21, 22))
+	for range 20 {
+		g := weightedgraph.Undirected[int, int]().Build()
+		for node := range 30 {
+			g.AddNode(node)
+		}
+		for range 50 {
+			source, target := r.IntN(30), r.IntN(30)
+			if source != target {
+				g.PutEdgeValue(source, target, r.IntN(100))
+			}
+		}
+
+		kruskalForest, kruskalWeight, err := weightedgraph.Kruskal(g)
+		if err != nil {
+			t.Fatalf("weightedgraph.Kruskal: got error %v, want nil", err)
+		}
+		primForest, primWeight, err := weightedgraph.Prim(g)
+		if err != nil {
+			t.Fatalf("weightedgraph.Prim: got error %v, want nil", err)
+		}
+
+		if kruskalWeight != primWeight {
+			t.Errorf(
+				"weightedgraph.Kruskal: got total weight %d, want %d like weightedgraph.Prim",
+				kruskalWeight,
+				primWeight,
+			)
+		}
+		if got, want := kruskalForest.Edges().Len(), primForest.Edges().Len(); got != want {
+			t.Errorf(
+				"weightedgraph.Kruskal: got %d edges, want %d like weightedgraph.Prim",
+				got,
+				want,
+			)
+		}
+	}
+}
+
+func TestMinimumSpanningForestFunc(t *testing.T) {
+	t.Parallel()
+
+	type cable struct {
+		costPerMeter float64
+		meters       float64
+	}
+	g := weightedgraph.Undirected[string, cable]().Build()
+	g.PutEdgeValue("a", "b", cable{costPerMeter: 2, meters: 10})
+	g.PutEdgeValue("b", "c", cable{costPerMeter: 1, meters: 10})
+	g.PutEdgeValue("a", "c", cable{costPerMeter: 10, meters: 1})
+
+	cost := func(c cable) float64 { return c.costPerMeter * c.meters }
+	for _, f := range []struct {
+		name string
+		find func(
+			g weightedgraph.GraphView[string, cable],
+			weight func(cable) float64,
+		) (*weightedgraph.WeightedGraph[string, cable], float64, error)
+	}{
+		{
+			name: "KruskalFunc",
+			find: weightedgraph.KruskalFunc[string, cable, float64],
+		},
+		{
+			name: "PrimFunc",
+			find: weightedgraph.PrimFunc[string, cable, float64],
+		},
+	} {
+		forest, totalWeight, err := f.find(g, cost)
+
+		if err != nil {
+			t.Fatalf("weightedgraph.%s: got error %v, want nil", f.name, err)
+		}
+
+		if totalWeight != 20 {
+			t.Errorf("weightedgraph.%s: got total weight %v, want 20", f.name, totalWeight)
+		}
+		want := cable{costPerMeter: 10, meters: 1}
+		if got, ok := forest.EdgeValue("c", "a"); !ok || got != want {
+			t.Errorf(
+				"weightedgraph.%s: got edge value (%v, %t), want (%v, true)",
+				f.name,
+				got,
+				ok,
+				want,
+			)
+		}
+	}
+}
+
+// assertSpanningForest checks that forest is a spanning forest of g with the
+// given number of trees, with edges that have the same values as in g and add
+// up to totalWeight.
+func assertSpanningForest(
+	t *testing.T,
+	name string,
+	g *weightedgraph.WeightedGraph[string, int],
+	forest *weightedgraph.WeightedGraph[string, int],
+	totalWeight int,
+	trees int,
+) {
+	t.Helper()
+
+	if forest.IsDirected() {
+		t.Errorf("weightedgraph.%s: got a directed graph, want an undirected one", name)
+	}
+	if got, want := forest.Nodes().Len(), g.Nodes().Len(); got != want {
+		t.Errorf("weightedgraph.%s: got %d nodes, want %d", name, got, want)
+	}
+	// A forest with V nodes and the given number of trees has V - trees edges.
+	if got, want := forest.Edges().Len(), g.Nodes().Len()-trees; got != want {
+		t.Errorf("weightedgraph.%s: got %d edges, want %d", name, got, want)
+	}
+
+	sum := 0
+	for edge := range forest.Edges().All() {
+		got, _ := forest.EdgeValue(edge.Source(), edge.Target())
+		want, ok := g.EdgeValue(edge.Source(), edge.Target())
+		if !ok || got != want {
+			t.Errorf(
+				"weightedgraph.%s: got edge %v with value %d, want an edge of g with value %d",
+				name,
+				edge,
+				got,
+				want,
+			)
+		}
+		sum += got
+	}
+	if sum != totalWeight {
+		t.Errorf(
+			"weightedgraph.%s: got edges with total weight %d, want %d",
+			name,
+			sum,
+			totalWeight,
+		)
+	}
+
+	// Every node reachable from a node in g must be reachable in the forest
+	// too, or it does not span g.
+	for node := range g.Nodes().All() {
+		if got, want := len(graph.Distances(forest, node)), len(graph.Distances(g, node)); got != want {
+			t.Errorf(
+				"weightedgraph.%s: got %d nodes reachable from %s, want %d",
+				name,
+				got,
+				node,
+				want,
+			)
+		}
+	}
+}
+
+// mstTextbookGraph returns the undirected graph that is used to demonstrate
+// minimum spanning trees in "Introduction to Algorithms" by Cormen et al.
+func mstTextbookGraph() *weightedgraph.WeightedGraph[string, int] {
+	g := weightedgraph.Undirected[string, int]().Build()
+	g.PutEdgeValue("a", "b", 4)
+	g.PutEdgeValue("a", "h", 8)
+	g.PutEdgeValue("b", "c", 8)
+	g.PutEdgeValue("b", "h", 11)
+	g.PutEdgeValue("c", "d", 7)
+	g.PutEdgeValue("c", "f", 4)
+	g.PutEdgeValue("c", "i", 2)
+	g.PutEdgeValue("d", "e", 9)
+	g.PutEdgeValue("d", "f", 14)
+	g.PutEdgeValue("e", "f", 10)
+	g.PutEdgeValue("f", "g", 2)
+	g.PutEdgeValue("g", "h", 1)
+	g.PutEdgeValue("g", "i", 6)
+	g.PutEdgeValue("h", "i", 7)
+	return g
+}
@@ -0,0 +1,84 @@
+package weightedgraph
+
+import (
+	"container/heap"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/set"
+)
+
+// Prim returns a minimum spanning forest of undirected graph g, where the
+// weight of each edge is its value, as a new undirected graph, along with its
+// total weight. It returns the same kind of forest as Kruskal.
+//
+// Prim grows a tree from one node of each connected component at a time,
+// always adding the lightest edge that reaches a node not yet in the tree, so
+// it runs in O(E log V) time. It can be faster than Kruskal on dense graphs.
+// It returns graph.ErrDirected if g is directed.
+//
+// The returned graph is a copy; it does not reflect later changes to g.
+func Prim[N comparable, W Weight](
+	g GraphView[N, W],
+) (*WeightedGraph[N, W], W, error) {
+	return PrimFunc(g, identity[W])
+}
+
+// PrimFunc is like Prim, but the weight of each edge is the result of calling
+// weight with its value, so it works with any type of edge value.
+func PrimFunc[N comparable, V any, W Weight](
+	g GraphView[N, V],
+	weight func(value V) W,
+) (*WeightedGraph[N, V], W, error) {
+	if g.IsDirected() {
+		var zero W
+		return nil, zero, graph.ErrDirected
+	}
+
+	result := newSpanningForest[N, V](g)
+	var totalWeight W
+	inForest := set.Of[N]()
+	// nodeToLightest has, for each node that is next to but not yet in the
+	// current tree, the weight of the lightest edge between them.
+	nodeToLightest := make(map[N]W)
+	nodeToParent := make(map[N]N)
+	for root := range g.Nodes().All() {
+		if inForest.Contains(root) {
+			continue
+		}
+
+		queue := &priorityQueue[N, W]{}
+		heap.Push(queue, prioritizedNode[N, W]{node: root, priority: 0})
+		for queue.Len() > 0 {
+			entry := heap.Pop(queue).(prioritizedNode[N, W])
+			node := entry.node
+			if !inForest.Add(node) {
+				// This is an outdated entry for a node that was already
+				// reached by a lighter edge.
+				continue
+			}
+			if parent, ok := nodeToParent[node]; ok {
+				value, _ := g.EdgeValue(parent, node)
+				result.PutEdgeValue(parent, node, value)
+				totalWeight += entry.priority
+			}
+
+			for next := range g.Successors(node).All() {
+				if inForest.Contains(next) {
+					continue
+				}
+				value, _ := g.EdgeValue(node, next)
+				nextWeight := weight(value)
+				if lightest, ok := nodeToLightest[next]; ok && lightest <= nextWeight {
+					continue
+				}
+				nodeToLightest[next] = nextWeight
+				nodeToParent[next] = node
+				heap.Push(
+					queue,
+					prioritizedNode[N, W]{node: next, priority: nextWeight},
+				)
+			}
+		}
+	}
+	return result, totalWeight, nil
+}
@@ -0,0 +1,69 @@
+package weightedgraph
+
+import (
+	"maps"
+	"slices"
+)
+
+// ShortestPathTree holds the shortest paths from one node, the root, to every
+// node that is reachable from it in a graph, as found by a function like
+// Dijkstra. Each reachable node has a distance, which is the total weight of a
+// shortest path to it, and, except for the root, a predecessor, which is the
+// node before it on that path.
+type ShortestPathTree[N comparable, W Weight] struct {
+	root              N
+	nodeToDistance    map[N]W
+	nodeToPredecessor map[N]N
+}
+
+func newShortestPathTree[N comparable, W Weight](root N) *ShortestPathTree[N, W] {
+	return &ShortestPathTree[N, W]{
+		root:              root,
+		nodeToDistance:    make(map[N]W),
+		nodeToPredecessor: make(map[N]N),
+	}
+}
+
+// Root returns the node that all the paths in this tree start from.
+func (t *ShortestPathTree[N, W]) Root() N {
+	return t.root
+}
+
+// Distance returns the total weight of a shortest path from the root to the
+// given node and true, or the zero value and false if the node is not
+// reachable from the root.
+func (t *ShortestPathTree[N, W]) Distance(node N) (W, bool) {
+	distance, ok := t.nodeToDistance[node]
+	return distance, ok
+}
+
+// Distances returns a new map from every node that is reachable from the root,
+// including the root itself, to its distance.
+func (t *ShortestPathTree[N, W]) Distances() map[N]W {
+	return maps.Clone(t.nodeToDistance)
+}
+
+// Predecessor returns the node before the given node on a shortest path from
+// the root and true, or the zero value and false if the node is the root or
+// is not reachable from the root.
+func (t *ShortestPathTree[N, W]) Predecessor(node N) (N, bool) {
+	predecessor, ok := t.nodeToPredecessor[node]
+	return predecessor, ok
+}
+
+// PathTo returns a shortest path from the root to the given node, as a slice
+// of nodes that starts with the root and ends with the given node, and true.
+// If the node is not reachable from the root, it returns nil and false.
+func (t *ShortestPathTree[N, W]) PathTo(node N) ([]N, bool) {
+	if _, ok := t.nodeToDistance[node]; !ok {
+		return nil, false
+	}
+
+	path := []N{node}
+	for node != t.root {
+		node = t.nodeToPredecessor[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path, true
+}
@@ -0,0 +1,61 @@
+package weightedgraph
+
+// unionFind is a disjoint-set forest, which keeps track of which nodes are in
+// the same component as edges are added between them.
+type unionFind[N comparable] struct {
+	nodeToParent map[N]N
+	nodeToSize   map[N]int
+}
+
+func newUnionFind[N comparable]() *unionFind[N] {
+	return &unionFind[N]{
+		nodeToParent: make(map[N]N),
+		nodeToSize:   make(map[N]int),
+	}
+}
+
+// find returns the representative node of the component that node is in.
+func (u *unionFind[N]) find(node N) N {
+	for {
+		parent, ok := u.nodeToParent[node]
+		if !ok || parent == node {
+			return node
+		}
+		// Path halving: point node at its grandparent and carry on from
+		// there, skipping every other node on the way up, which keeps the
+		// trees shallow.
+		grandparent, ok := u.nodeToParent[parent]
+		if !ok {
+			return parent
+		}
+		u.nodeToParent[node] = grandparent
+		node = grandparent
+	}
+}
+
+// union merges the components that a and b are in. Returns true if they were
+// in different components, otherwise false.
+func (u *unionFind[N]) union(a N, b N) bool {
+	a, b = u.find(a), u.find(b)
+	if a == b {
+		return false
+	}
+
+	// Hang the smaller tree under the larger one, which also keeps the trees
+	// shallow.
+	aSize, bSize := u.size(a), u.size(b)
+	if aSize < bSize {
+		a, b = b, a
+	}
+	u.nodeToParent[b] = a
+	u.nodeToSize[a] = aSize + bSize
+	delete(u.nodeToSize, b)
+	return true
+}
+
+func (u *unionFind[N]) size(root N) int {
+	if size, ok := u.nodeToSize[root]; ok {
+		return size
+	}
+	return 1
+}
@@ -0,0 +1,131 @@
+package weightedgraph
+
+import "testing"
+
+func TestUnionFind(t *testing.T) {
+	t.Parallel()
+
+	t.Run("find of a new node returns the node", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUnionFind[int]()
+
+		if got := u.find(1); got != 1 {
+			t.Errorf("unionFind.find: got %d, want 1", got)
+		}
+	})
+
+	t.Run("union of different components returns true", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUnionFind[int]()
+
+		if !u.union(1, 2) {
+			t.Errorf("unionFind.union: got false, want true")
+		}
+		if !u.union(3, 2) {
+			t.Errorf("unionFind.union: got false, want true")
+		}
+	})
+
+	t.Run("union of the same component returns false", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUnionFind[int]()
+		u.union(1, 2)
+		u.union(2, 3)
+
+		if u.union(3, 1) {
+			t.Errorf("unionFind.union: got true, want false")
+		}
+		if u.union(4, 4) {
+			t.Errorf("unionFind.union: got true, want false")
+		}
+	})
+
+	t.Run("find returns the same node for a component", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUnionFind[int]()
+		u.union(1, 2)
+		u.union(3, 4)
+		u.union(2, 4)
+		u.union(5, 6)
+
+		root := u.find(1)
+		for _, node := range []int{2, 3, 4} {
+			if got := u.find(node); got != root {
+				t.Errorf("unionFind.find(%d): got %d, want %d", node, got, root)
+			}
+		}
+		if got := u.find(5); got == root || got != u.find(6) {
+			t.Errorf(
+				"unionFind.find(5): got %d, want %d and not %d",
+				got,
+				u.find(6),
+				root,
+			)
+		}
+	})
+
+	t.Run("union hangs the smaller tree under the larger one", func(t *testing.T) {
+		t.Parallel()
+
+		u := newUnionFind[int]()
+		u.union(1, 2)
+		u.union(1, 3)
+		root := u.find(1)
+
+		u.union(4, 1)
+
+		if got := u.find(4); got != root {
+			t.Errorf("unionFind.find(4): got %d, want %d", got, root)
+		}
+		if got := u.size(root); got != 4 {
+			t.Errorf("unionFind.size: got %d, want 4", got)
+		}
+	})
+
+	t.Run("find shortens the path to the root", func(t *testing.T) {
+		t.Parallel()
+
+		// Make a chain 7 -> 6 -> ... -> 0 by hand, since union by size never
+		// makes one.
+		u := newUnionFind[int]()
+		for node := 1; node < 8; node++ {
+			u.nodeToParent[node] = node - 1
+		}
+		before := depth(u, 7)
+
+		if got := u.find(7); got != 0 {
+			t.Errorf("unionFind.find: got %d, want 0", got)
+		}
+
+		if after := depth(u, 7); after >= before {
+			t.Errorf(
+				"unionFind.find: got a path of length %d after find, want "+
+					"shorter than %d",
+				after,
+				before,
+			)
+		}
+		for node := 1; node < 8; node++ {
+			if got := u.find(node); got != 0 {
+				t.Errorf("unionFind.find(%d): got %d, want 0", node, got)
+			}
+		}
+	})
+}
+
+// depth returns the number of parent links between node and its root.
+func depth[N comparable](u *unionFind[N], node N) int {
+	result := 0
+	for {
+		parent, ok := u.nodeToParent[node]
+		if !ok || parent == node {
+			return result
+		}
+		node = parent
+		result++
+	}
+}
@@ -0,0 +1,42 @@
+package weightedgraph
+
+import "github.com/jbduncan/go-containers/graph"
+
+// UnitWeighted returns a read-only view of graph g in which every edge has the
+// value 1, so that an unweighted graph can be passed to the functions in this
+// package, like FloydWarshall, which then count the edges on each path.
+//
+// The view is backed by g, so changes to g are reflected in it.
+func UnitWeighted[N comparable](g graph.GraphView[N]) GraphView[N, int] {
+	return unitWeightedGraph[N]{
+		GraphView: g,
+	}
+}
+
+type unitWeightedGraph[N comparable] struct {
+	graph.GraphView[N]
+}
+
+func (u unitWeightedGraph[N]) EdgeValue(source N, target N) (int, bool) {
+	if u.HasEdgeConnecting(source, target) {
+		return 1, true
+	}
+	return 0, false
+}
+
+func (u unitWeightedGraph[N]) EdgeValueOrDefault(
+	source N,
+	target N,
+	defaultValue int,
+) int {
+	if value, ok := u.EdgeValue(source, target); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (u unitWeightedGraph[N]) AsGraph() graph.GraphView[N] {
+	return unmodifiableGraph[N]{
+		GraphView: u.GraphView,
+	}
+}
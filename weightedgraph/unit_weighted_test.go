@@ -0,0 +1,47 @@
+package weightedgraph_test
+
+import (
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestUnitWeighted(t *testing.T) {
+	t.Parallel()
+
+	g := graph.Undirected[string]().Build()
+	g.PutEdge("a", "b")
+	g.AddNode("c")
+
+	unitWeighted := weightedgraph.UnitWeighted[string](g)
+
+	if got, ok := unitWeighted.EdgeValue("b", "a"); !ok || got != 1 {
+		t.Errorf("GraphView.EdgeValue: got (%d, %t), want (1, true)", got, ok)
+	}
+	if got, ok := unitWeighted.EdgeValue("a", "c"); ok {
+		t.Errorf("GraphView.EdgeValue: got (%d, %t), want (0, false)", got, ok)
+	}
+	if got := unitWeighted.EdgeValueOrDefault("a", "c", 7); got != 7 {
+		t.Errorf("GraphView.EdgeValueOrDefault: got %d, want 7", got)
+	}
+	if got := unitWeighted.EdgeValueOrDefault("a", "b", 7); got != 1 {
+		t.Errorf("GraphView.EdgeValueOrDefault: got %d, want 1", got)
+	}
+
+	g.PutEdge("a", "c")
+
+	if got, ok := unitWeighted.EdgeValue("a", "c"); !ok || got != 1 {
+		t.Errorf(
+			"GraphView.EdgeValue: got (%d, %t), want (1, true) after changing the graph",
+			got,
+			ok,
+		)
+	}
+	if !graph.Equal[string](unitWeighted.AsGraph(), g) {
+		t.Errorf("GraphView.AsGraph: got %v, want %v", unitWeighted.AsGraph(), g)
+	}
+	if _, ok := unitWeighted.AsGraph().(graph.MutableGraph[string]); ok {
+		t.Errorf("GraphView.AsGraph: got a graph.MutableGraph, want a read-only view")
+	}
+}
@@ -0,0 +1,17 @@
+package weightedgraph
+
+// Weight is a constraint for the types of the weights that the shortest path
+// algorithms in this package can add together and compare, which are the
+// built-in integer and floating-point types and the types based on them.
+//
+// The algorithms do not check for overflow, so integer weights should be
+// small enough that no path can overflow W.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func identity[W Weight](weight W) W {
+	return weight
+}
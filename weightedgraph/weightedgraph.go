@@ -1,19 +1,237 @@
 package weightedgraph
 
-func Undirected[N comparable]() Builder[N] {
-	return Builder[N]{
+import "github.com/jbduncan/go-containers/graph"
+
+func Undirected[N comparable, V any]() Builder[N, V] {
+	return Builder[N, V]{
 		directed:        false,
 		allowsSelfLoops: false,
 	}
 }
 
-type Builder[N comparable] struct {
+func Directed[N comparable, V any]() Builder[N, V] {
+	return Builder[N, V]{
+		directed:        true,
+		allowsSelfLoops: false,
+	}
+}
+
+type Builder[N comparable, V any] struct {
 	directed        bool
 	allowsSelfLoops bool
 }
 
-func (b Builder[N]) Build() *WeightedGraph[N] {
-	return &WeightedGraph[N]{}
+func (b Builder[N, V]) AllowsSelfLoops(allowsSelfLoops bool) Builder[N, V] {
+	b.allowsSelfLoops = allowsSelfLoops
+	return b
+}
+
+func (b Builder[N, V]) Build() *WeightedGraph[N, V] {
+	delegate := graph.Undirected[N]()
+	if b.directed {
+		delegate = graph.Directed[N]()
+	}
+
+	return &WeightedGraph[N, V]{
+		graph:       delegate.AllowsSelfLoops(b.allowsSelfLoops).Build(),
+		edgeToValue: make(map[graph.EndpointPair[N]]V),
+	}
+}
+
+// GraphView is a read-only graph whose edges each have a value of type V, like
+// a weight, a capacity or a label. It is a graph.GraphView too, so it can be
+// used wherever one can.
+type GraphView[N comparable, V any] interface {
+	graph.GraphView[N]
+
+	// EdgeValue returns the value of the edge from source to target and true
+	// if there is such an edge in this graph, otherwise it returns the zero
+	// value and false. If this graph is undirected, the order of source and
+	// target does not matter.
+	EdgeValue(source N, target N) (V, bool)
+
+	// EdgeValueOrDefault is like EdgeValue, but it returns defaultValue if
+	// there is no edge from source to target in this graph.
+	EdgeValueOrDefault(source N, target N, defaultValue V) V
+
+	// AsGraph returns a read-only view of this graph without its edge values.
+	AsGraph() graph.GraphView[N]
+}
+
+// WeightedGraph is a graph whose edges each have a value of type V. It is made
+// with a Builder, like:
+//
+//	g := weightedgraph.Directed[string, float64]().Build()
+//	g.PutEdgeValue("a", "b", 1.5)
+type WeightedGraph[N comparable, V any] struct {
+	graph *graph.Graph[N]
+	// edgeToValue has each edge in both directions if this graph is
+	// undirected.
+	edgeToValue map[graph.EndpointPair[N]]V
+}
+
+func (w *WeightedGraph[N, V]) IsDirected() bool {
+	return w.graph.IsDirected()
+}
+
+func (w *WeightedGraph[N, V]) AllowsSelfLoops() bool {
+	return w.graph.AllowsSelfLoops()
+}
+
+func (w *WeightedGraph[N, V]) Nodes() graph.SetView[N] {
+	return w.graph.Nodes()
+}
+
+func (w *WeightedGraph[N, V]) Edges() graph.SetView[graph.EndpointPair[N]] {
+	return w.graph.Edges()
+}
+
+func (w *WeightedGraph[N, V]) AdjacentNodes(node N) graph.SetView[N] {
+	return w.graph.AdjacentNodes(node)
+}
+
+func (w *WeightedGraph[N, V]) Predecessors(node N) graph.SetView[N] {
+	return w.graph.Predecessors(node)
+}
+
+func (w *WeightedGraph[N, V]) Successors(node N) graph.SetView[N] {
+	return w.graph.Successors(node)
+}
+
+func (w *WeightedGraph[N, V]) IncidentEdges(
+	node N,
+) graph.SetView[graph.EndpointPair[N]] {
+	return w.graph.IncidentEdges(node)
+}
+
+func (w *WeightedGraph[N, V]) Degree(node N) int {
+	return w.graph.Degree(node)
+}
+
+func (w *WeightedGraph[N, V]) InDegree(node N) int {
+	return w.graph.InDegree(node)
+}
+
+func (w *WeightedGraph[N, V]) OutDegree(node N) int {
+	return w.graph.OutDegree(node)
+}
+
+func (w *WeightedGraph[N, V]) HasEdgeConnecting(source N, target N) bool {
+	return w.graph.HasEdgeConnecting(source, target)
+}
+
+func (w *WeightedGraph[N, V]) HasEdgeConnectingEndpoints(
+	endpointPair graph.EndpointPair[N],
+) bool {
+	return w.graph.HasEdgeConnectingEndpoints(endpointPair)
+}
+
+func (w *WeightedGraph[N, V]) EdgeValue(source N, target N) (V, bool) {
+	value, ok := w.edgeToValue[graph.EndpointPairOf(source, target)]
+	return value, ok
+}
+
+func (w *WeightedGraph[N, V]) EdgeValueOrDefault(
+	source N,
+	target N,
+	defaultValue V,
+) V {
+	if value, ok := w.EdgeValue(source, target); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (w *WeightedGraph[N, V]) AsGraph() graph.GraphView[N] {
+	// Wrap the graph so that it cannot be changed by type asserting it back
+	// to a *graph.Graph.
+	return unmodifiableGraph[N]{
+		GraphView: w.graph,
+	}
+}
+
+func (w *WeightedGraph[N, V]) String() string {
+	return w.graph.String()
+}
+
+// AddNode adds the given node to this graph. Returns true if this graph
+// changed as a result of this call, otherwise false.
+func (w *WeightedGraph[N, V]) AddNode(node N) bool {
+	return w.graph.AddNode(node)
+}
+
+// PutEdgeValue adds an edge from source to target with the given value to this
+// graph, adding the nodes too if they are not already present. If there was
+// already such an edge, its value is replaced. Returns the previous value of
+// the edge and true if there was one, otherwise the zero value and false.
+//
+// PutEdgeValue panics with a *graph.EdgeError wrapping
+// graph.ErrSelfLoopNotAllowed if source and target are the same node and this
+// graph disallows self-loops.
+func (w *WeightedGraph[N, V]) PutEdgeValue(
+	source N,
+	target N,
+	value V,
+) (V, bool) {
+	previous, ok := w.EdgeValue(source, target)
+	if _, err := w.TryPutEdgeValue(source, target, value); err != nil {
+		panic(err)
+	}
+	return previous, ok
+}
+
+// TryPutEdgeValue is like PutEdgeValue, but it returns an error instead of
+// panicking. Returns true if the edge was added, or false if there was
+// already such an edge, in which case its value is replaced.
+//
+// If source and target are the same node and this graph disallows
+// self-loops, TryPutEdgeValue returns a *graph.EdgeError wrapping
+// graph.ErrSelfLoopNotAllowed and leaves this graph unchanged.
+func (w *WeightedGraph[N, V]) TryPutEdgeValue(
+	source N,
+	target N,
+	value V,
+) (bool, error) {
+	put, err := w.graph.TryPutEdge(source, target)
+	if err != nil {
+		return false, err
+	}
+
+	w.edgeToValue[graph.EndpointPairOf(source, target)] = value
+	if !w.IsDirected() {
+		w.edgeToValue[graph.EndpointPairOf(target, source)] = value
+	}
+	return put, nil
+}
+
+// RemoveNode removes the given node and all of its incident edges from this
+// graph. Returns true if this graph changed as a result of this call,
+// otherwise false.
+func (w *WeightedGraph[N, V]) RemoveNode(node N) bool {
+	for adjacentNode := range w.AdjacentNodes(node).All() {
+		delete(w.edgeToValue, graph.EndpointPairOf(node, adjacentNode))
+		delete(w.edgeToValue, graph.EndpointPairOf(adjacentNode, node))
+	}
+	return w.graph.RemoveNode(node)
 }
 
-type WeightedGraph[N comparable] struct{}
+// RemoveEdge removes the edge from source to target from this graph, but not
+// the nodes themselves. Returns the value of the removed edge and true if
+// there was such an edge, otherwise the zero value and false.
+func (w *WeightedGraph[N, V]) RemoveEdge(source N, target N) (V, bool) {
+	value, ok := w.EdgeValue(source, target)
+	if !ok {
+		return value, false
+	}
+
+	w.graph.RemoveEdge(source, target)
+	delete(w.edgeToValue, graph.EndpointPairOf(source, target))
+	if !w.IsDirected() {
+		delete(w.edgeToValue, graph.EndpointPairOf(target, source))
+	}
+	return value, true
+}
+
+type unmodifiableGraph[N comparable] struct {
+	graph.GraphView[N]
+}
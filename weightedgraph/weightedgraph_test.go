@@ -0,0 +1,450 @@
+package weightedgraph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jbduncan/go-containers/graph"
+	"github.com/jbduncan/go-containers/graph/graphtest"
+	"github.com/jbduncan/go-containers/weightedgraph"
+)
+
+func TestUndirectedWeightedGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return weightedgraph.Undirected[int, int]().Build()
+		},
+		addNodeToWeightedGraph,
+		putEdgeToWeightedGraph,
+		graphtest.Undirected,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestUndirectedAllowsSelfLoopsWeightedGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return weightedgraph.Undirected[int, int]().AllowsSelfLoops(true).Build()
+		},
+		addNodeToWeightedGraph,
+		putEdgeToWeightedGraph,
+		graphtest.Undirected,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestDirectedWeightedGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return weightedgraph.Directed[int, int]().Build()
+		},
+		addNodeToWeightedGraph,
+		putEdgeToWeightedGraph,
+		graphtest.Directed,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestDirectedAllowsSelfLoopsWeightedGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return weightedgraph.Directed[int, int]().AllowsSelfLoops(true).Build()
+		},
+		addNodeToWeightedGraph,
+		putEdgeToWeightedGraph,
+		graphtest.Directed,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestUndirectedWeightedGraphAsGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return newGraphView(weightedgraph.Undirected[int, int]().Build())
+		},
+		addNodeToGraphView,
+		putEdgeToGraphView,
+		graphtest.Undirected,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestUndirectedAllowsSelfLoopsWeightedGraphAsGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return newGraphView(
+				weightedgraph.Undirected[int, int]().AllowsSelfLoops(true).Build(),
+			)
+		},
+		addNodeToGraphView,
+		putEdgeToGraphView,
+		graphtest.Undirected,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestDirectedWeightedGraphAsGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return newGraphView(weightedgraph.Directed[int, int]().Build())
+		},
+		addNodeToGraphView,
+		putEdgeToGraphView,
+		graphtest.Directed,
+		graphtest.DisallowsSelfLoops,
+	)
+}
+
+func TestDirectedAllowsSelfLoopsWeightedGraphAsGraph(t *testing.T) {
+	t.Parallel()
+
+	graphtest.TestReadOnly(
+		t,
+		func() graphtest.Graph[int] {
+			return newGraphView(
+				weightedgraph.Directed[int, int]().AllowsSelfLoops(true).Build(),
+			)
+		},
+		addNodeToGraphView,
+		putEdgeToGraphView,
+		graphtest.Directed,
+		graphtest.AllowsSelfLoops,
+	)
+}
+
+func TestWeightedGraphEdgeValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("putting a new edge value", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, float64]().Build()
+
+		previous, ok := g.PutEdgeValue("a", "b", 1.5)
+
+		if ok || previous != 0 {
+			t.Errorf(
+				"WeightedGraph.PutEdgeValue: got (%v, %t), want (0, false)",
+				previous,
+				ok,
+			)
+		}
+		if got, ok := g.EdgeValue("a", "b"); !ok || got != 1.5 {
+			t.Errorf(
+				"WeightedGraph.EdgeValue: got (%v, %t), want (1.5, true)",
+				got,
+				ok,
+			)
+		}
+	})
+
+	t.Run("putting an existing edge value replaces it", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, float64]().Build()
+		g.PutEdgeValue("a", "b", 1.5)
+
+		previous, ok := g.PutEdgeValue("a", "b", 2.5)
+
+		if !ok || previous != 1.5 {
+			t.Errorf(
+				"WeightedGraph.PutEdgeValue: got (%v, %t), want (1.5, true)",
+				previous,
+				ok,
+			)
+		}
+		if got := g.EdgeValueOrDefault("a", "b", 0); got != 2.5 {
+			t.Errorf("WeightedGraph.EdgeValueOrDefault: got %v, want 2.5", got)
+		}
+		if got := g.Edges().Len(); got != 1 {
+			t.Errorf("WeightedGraph.Edges.Len: got %d, want 1", got)
+		}
+	})
+
+	t.Run("directed edge values have a direction", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, float64]().Build()
+		g.PutEdgeValue("a", "b", 1.5)
+
+		if got, ok := g.EdgeValue("b", "a"); ok {
+			t.Errorf(
+				"WeightedGraph.EdgeValue: got (%v, %t), want (0, false)",
+				got,
+				ok,
+			)
+		}
+		if got := g.EdgeValueOrDefault("b", "a", -1); got != -1 {
+			t.Errorf("WeightedGraph.EdgeValueOrDefault: got %v, want -1", got)
+		}
+	})
+
+	t.Run("undirected edge values have no direction", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, float64]().Build()
+		g.PutEdgeValue("a", "b", 1.5)
+		g.PutEdgeValue("b", "a", 2.5)
+
+		if got, ok := g.EdgeValue("a", "b"); !ok || got != 2.5 {
+			t.Errorf(
+				"WeightedGraph.EdgeValue: got (%v, %t), want (2.5, true)",
+				got,
+				ok,
+			)
+		}
+		if got := g.Edges().Len(); got != 1 {
+			t.Errorf("WeightedGraph.Edges.Len: got %d, want 1", got)
+		}
+	})
+
+	t.Run("removing an edge returns its value", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, float64]().Build()
+		g.PutEdgeValue("a", "b", 1.5)
+
+		removed, ok := g.RemoveEdge("b", "a")
+
+		if !ok || removed != 1.5 {
+			t.Errorf(
+				"WeightedGraph.RemoveEdge: got (%v, %t), want (1.5, true)",
+				removed,
+				ok,
+			)
+		}
+		if _, ok := g.EdgeValue("a", "b"); ok {
+			t.Errorf("WeightedGraph.EdgeValue: got true, want false")
+		}
+		if g.HasEdgeConnecting("a", "b") {
+			t.Errorf("WeightedGraph.HasEdgeConnecting: got true, want false")
+		}
+	})
+
+	t.Run("removing an absent edge returns false", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, float64]().Build()
+		g.PutEdgeValue("a", "b", 1.5)
+
+		if removed, ok := g.RemoveEdge("b", "a"); ok {
+			t.Errorf(
+				"WeightedGraph.RemoveEdge: got (%v, %t), want (0, false)",
+				removed,
+				ok,
+			)
+		}
+		if got := g.Edges().Len(); got != 1 {
+			t.Errorf("WeightedGraph.Edges.Len: got %d, want 1", got)
+		}
+	})
+
+	t.Run("removing a node removes its edge values", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, float64]().Build()
+		g.PutEdgeValue("a", "b", 1.5)
+		g.PutEdgeValue("b", "a", 2.5)
+		g.PutEdgeValue("b", "c", 3.5)
+
+		if !g.RemoveNode("b") {
+			t.Errorf("WeightedGraph.RemoveNode: got false, want true")
+		}
+		for _, edge := range []graph.EndpointPair[string]{
+			graph.EndpointPairOf("a", "b"),
+			graph.EndpointPairOf("b", "a"),
+			graph.EndpointPairOf("b", "c"),
+		} {
+			if previous, ok := g.PutEdgeValue(edge.Source(), edge.Target(), 0); ok {
+				t.Errorf(
+					"WeightedGraph.PutEdgeValue: got (%v, %t) for %v after "+
+						"removing its node, want (0, false)",
+					previous,
+					ok,
+					edge,
+				)
+			}
+		}
+	})
+
+	t.Run("putting a disallowed self-loop panics", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Directed[string, float64]().Build()
+
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, graph.ErrSelfLoopNotAllowed) {
+				t.Errorf(
+					"WeightedGraph.PutEdgeValue: got panic %v, want %v",
+					err,
+					graph.ErrSelfLoopNotAllowed,
+				)
+			}
+		}()
+		g.PutEdgeValue("a", "a", 1.5)
+	})
+
+	t.Run("try putting a new edge value returns true", func(t *testing.T) {
+		t.Parallel()
+
+		g := weightedgraph.Undirected[string, float64]().Build()
+
+		put, err := g.TryPutEdgeValue("a", "b", 1.5)
+
+		if err != nil {
+			t.Fatalf("WeightedGraph.TryPutEdgeValue: got error %v, want nil", err)
+		}
+		if !put {
+			t.Errorf("WeightedGraph.TryPutEdgeValue: got false, want true")
+		}
+		if got, ok := g.EdgeValue("b", "a"); !ok || got != 1.5 {
+			t.Errorf(
+				"WeightedGraph.EdgeValue: got (%v, %t), want (1.5, true)",
+				got,
+				ok,
+			)
+		}
+	})
+
+	t.Run(
+		"try putting an existing edge value returns false and replaces it",
+		func(t *testing.T) {
+			t.Parallel()
+
+			g := weightedgraph.Directed[string, float64]().Build()
+			g.PutEdgeValue("a", "b", 1.5)
+
+			put, err := g.TryPutEdgeValue("a", "b", 2.5)
+
+			if err != nil {
+				t.Fatalf(
+					"WeightedGraph.TryPutEdgeValue: got error %v, want nil",
+					err,
+				)
+			}
+			if put {
+				t.Errorf("WeightedGraph.TryPutEdgeValue: got true, want false")
+			}
+			if got := g.EdgeValueOrDefault("a", "b", 0); got != 2.5 {
+				t.Errorf(
+					"WeightedGraph.EdgeValueOrDefault: got %v, want 2.5",
+					got,
+				)
+			}
+		},
+	)
+
+	t.Run(
+		"try putting a disallowed self-loop returns an EdgeError",
+		func(t *testing.T) {
+			t.Parallel()
+
+			g := weightedgraph.Directed[string, float64]().Build()
+
+			put, err := g.TryPutEdgeValue("a", "a", 1.5)
+
+			var edgeErr *graph.EdgeError[string]
+			if !errors.As(err, &edgeErr) ||
+				!errors.Is(err, graph.ErrSelfLoopNotAllowed) {
+				t.Fatalf(
+					"WeightedGraph.TryPutEdgeValue: got error %v, want "+
+						"*graph.EdgeError wrapping %v",
+					err,
+					graph.ErrSelfLoopNotAllowed,
+				)
+			}
+			if put {
+				t.Errorf("WeightedGraph.TryPutEdgeValue: got true, want false")
+			}
+			if got := g.Nodes().Len(); got != 0 {
+				t.Errorf("WeightedGraph.Nodes.Len: got %d, want 0", got)
+			}
+			if _, ok := g.EdgeValue("a", "a"); ok {
+				t.Errorf("WeightedGraph.EdgeValue: got true, want false")
+			}
+		},
+	)
+}
+
+func TestWeightedGraphAsGraphIsReadOnly(t *testing.T) {
+	t.Parallel()
+
+	g := weightedgraph.Directed[string, float64]().Build()
+
+	if _, ok := g.AsGraph().(graph.MutableGraph[string]); ok {
+		t.Errorf("WeightedGraph.AsGraph: got a graph.MutableGraph, want a read-only graph")
+	}
+}
+
+func addNodeToWeightedGraph(
+	g graphtest.Graph[int],
+	node int,
+) graphtest.Graph[int] {
+	g.(*weightedgraph.WeightedGraph[int, int]).AddNode(node)
+	return g
+}
+
+func putEdgeToWeightedGraph(
+	g graphtest.Graph[int],
+	source int,
+	target int,
+) graphtest.Graph[int] {
+	g.(*weightedgraph.WeightedGraph[int, int]).
+		PutEdgeValue(source, target, source+target)
+	return g
+}
+
+// graphView pairs the graph view of a weighted graph with the weighted graph
+// itself, so that graphtest.TestReadOnly can put edges into the view by
+// putting edge values into the weighted graph.
+type graphView struct {
+	graph.GraphView[int]
+
+	original *weightedgraph.WeightedGraph[int, int]
+}
+
+func newGraphView(original *weightedgraph.WeightedGraph[int, int]) graphView {
+	return graphView{
+		GraphView: original.AsGraph(),
+		original:  original,
+	}
+}
+
+func addNodeToGraphView(
+	g graphtest.Graph[int],
+	node int,
+) graphtest.Graph[int] {
+	g.(graphView).original.AddNode(node)
+	return g
+}
+
+func putEdgeToGraphView(
+	g graphtest.Graph[int],
+	source int,
+	target int,
+) graphtest.Graph[int] {
+	g.(graphView).original.PutEdgeValue(source, target, 0)
+	return g
+}